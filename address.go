@@ -0,0 +1,133 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Address is a parsed email address that can be stored in a database.
+// It implements the driver.Valuer and sql.Scanner interfaces.
+//
+// The normalized form (see String) is stored, so that a unique constraint on the
+// column treats equivalent addresses (e.g. john.smith+x@gmail.com and johnsmith@gmail.com)
+// as duplicates. To round-trip the original email address, store Original in a second column:
+//
+//	db.Exec("INSERT INTO users (email, email_original) VALUES ($1, $2)", addr, addr.Original())
+//	db.QueryRow("SELECT email, email_original FROM users WHERE id = $1", id).Scan(&addr, addr.Original())
+//
+// The zero value is stored as NULL.
+type Address struct {
+	ParsedEmail
+
+	// fromOriginal is true if the Address was last scanned from the original column.
+	fromOriginal bool
+}
+
+// NewAddress parses email and returns an Address. See ParseEmail.
+func NewAddress(email string, opts ...Option) (Address, error) {
+	p, err := ParseEmail(email, opts...)
+	if err := ignoreDetectorError(err); err != nil {
+		return Address{}, err
+	}
+	return Address{ParsedEmail: p}, nil
+}
+
+// String returns the normalized form of the email address: <Normalized>@<Domain>.
+// Provider aliases are replaced when parsing (e.g. googlemail.com becomes gmail.com).
+// It returns an empty string for the zero value.
+func (a Address) String() string {
+	if a.Domain == "" {
+		return ""
+	}
+	return a.Normalized + "@" + a.Domain
+}
+
+// Value implements the driver.Valuer interface. It returns the normalized form of
+// the email address.
+func (a Address) Value() (driver.Value, error) {
+	if a.Domain == "" {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. The stored value is parsed again. Unless the
+// original email address is also scanned (see Original), Email and LocalPart will hold
+// the normalized form.
+//
+// A NULL value results in the zero value.
+func (a *Address) Scan(src interface{}) error {
+	email, null, err := scanString(src)
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*a = Address{}
+		return nil
+	}
+
+	// Keep the original email address if it was scanned first and is equivalent
+	if a.fromOriginal && a.String() == email {
+		a.fromOriginal = false
+		return nil
+	}
+
+	addr, err := NewAddress(email)
+	if err != nil {
+		return err
+	}
+	*a = addr
+	return nil
+}
+
+// Original returns a column that stores and scans the original email address (i.e. Email).
+// It can be scanned before or after the Address itself.
+func (a *Address) Original() OriginalColumn {
+	return OriginalColumn{a}
+}
+
+// OriginalColumn stores and scans the original email address of an Address. See Address.Original.
+type OriginalColumn struct {
+	a *Address
+}
+
+// Value implements the driver.Valuer interface. It returns the original email address.
+func (c OriginalColumn) Value() (driver.Value, error) {
+	if c.a.Domain == "" {
+		return nil, nil
+	}
+	return c.a.Email, nil
+}
+
+// Scan implements the sql.Scanner interface. The original email address is parsed again.
+// A NULL value leaves the Address unchanged.
+func (c OriginalColumn) Scan(src interface{}) error {
+	email, null, err := scanString(src)
+	if err != nil || null {
+		return err
+	}
+
+	addr, err := NewAddress(email)
+	if err != nil {
+		return err
+	}
+	addr.fromOriginal = true
+	*c.a = addr
+	return nil
+}
+
+// scanString returns src as a string. null is true if src is NULL.
+func scanString(src interface{}) (s string, null bool, err error) {
+	switch v := src.(type) {
+	case nil:
+		return "", true, nil
+	case string:
+		return v, false, nil
+	case []byte:
+		return string(v), false, nil
+	}
+	return "", false, fmt.Errorf("disposable: cannot scan %T into Address", src)
+}
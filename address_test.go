@@ -0,0 +1,101 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Address{}
+	_ sql.Scanner   = (*Address)(nil)
+	_ driver.Valuer = OriginalColumn{}
+	_ sql.Scanner   = OriginalColumn{}
+)
+
+func TestAddressValue(t *testing.T) {
+	addr, err := NewAddress("John.Smith+x@googlemail.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, _ := addr.Value()
+	if v != "johnsmith@gmail.com" {
+		t.Errorf("Value() = %v, want johnsmith@gmail.com", v)
+	}
+
+	o, _ := addr.Original().Value()
+	if o != "John.Smith+x@googlemail.com" {
+		t.Errorf("Original().Value() = %v, want John.Smith+x@googlemail.com", o)
+	}
+
+	var zero Address
+	if v, _ := zero.Value(); v != nil {
+		t.Errorf("zero Value() = %v, want nil", v)
+	}
+	if v, _ := zero.Original().Value(); v != nil {
+		t.Errorf("zero Original().Value() = %v, want nil", v)
+	}
+}
+
+func TestAddressRoundTrip(t *testing.T) {
+	const email = "John.Smith+x@gmail.com"
+
+	addr, _ := NewAddress(email)
+	normalized, _ := addr.Value()
+	original, _ := addr.Original().Value()
+
+	// Scanning only the normalized column
+	var a Address
+	if err := a.Scan([]byte(normalized.(string))); err != nil {
+		t.Fatal(err)
+	}
+	if a.Email != "johnsmith@gmail.com" {
+		t.Errorf("Email = %s, want johnsmith@gmail.com", a.Email)
+	}
+
+	// Scanning both columns, in either order
+	orders := map[string][]func(*Address) error{
+		"address first": {
+			func(a *Address) error { return a.Scan(normalized) },
+			func(a *Address) error { return a.Original().Scan(original) },
+		},
+		"original first": {
+			func(a *Address) error { return a.Original().Scan(original) },
+			func(a *Address) error { return a.Scan(normalized) },
+		},
+	}
+
+	for name, scans := range orders {
+		var a Address
+		for i := 0; i < 2; i++ { // Reuse a, as when scanning multiple rows
+			for _, scan := range scans {
+				if err := scan(&a); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if a.Email != email || a.String() != "johnsmith@gmail.com" || a.Extra != "x" {
+				t.Errorf("%s: got %s (%s), want %s (johnsmith@gmail.com)", name, a.Email, a.String(), email)
+			}
+		}
+	}
+}
+
+func TestAddressScanNull(t *testing.T) {
+	a, _ := NewAddress("john@gmail.com")
+	if err := a.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if a.Domain != "" {
+		t.Errorf("got %v, want zero value", a)
+	}
+
+	if err := a.Scan(42); err == nil {
+		t.Error("Scan(42) must fail")
+	}
+	if err := a.Scan("not an email"); err == nil {
+		t.Error(`Scan("not an email") must fail`)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Result is the result of parsing an email address with ParseEmails or ParseEmailStream.
+type Result struct {
+	// Index is the position of the email address in the input.
+	Index int
+
+	ParsedEmail ParsedEmail
+
+	// Err is the error returned when parsing the email address.
+	Err error
+}
+
+// WithWorkers sets the number of goroutines used by ParseEmails and ParseEmailStream.
+// The default is runtime.NumCPU(). It has no effect on ParseEmail or a Checker.
+func WithWorkers(n int) Option {
+	return func(cfg *config) {
+		cfg.workers = n
+	}
+}
+
+func (cfg *config) numWorkers() int {
+	if cfg.workers <= 0 {
+		return runtime.NumCPU()
+	}
+	return cfg.workers
+}
+
+// ParseEmails parses multiple email addresses concurrently. The results are
+// returned in the same order as emails. See ParseEmail.
+func ParseEmails(emails []string, opts ...Option) []Result {
+	cfg := defaultConfig(opts...)
+
+	results := make([]Result, len(emails))
+
+	workers := cfg.numWorkers()
+	if workers > len(emails) {
+		workers = len(emails)
+	}
+
+	var (
+		next int64 = -1
+		wg   sync.WaitGroup
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(emails) {
+					return
+				}
+				p, err := parse(context.Background(), emails[i], &cfg)
+				results[i] = Result{Index: i, ParsedEmail: p, Err: err}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// ParseEmailStream parses the email addresses received from in concurrently.
+// The returned channel is closed after in is closed (or ctx is cancelled) and all
+// received email addresses have been parsed.
+//
+// NOTE: The results are not in the same order as the input. Use Result.Index
+// to correlate a result with its input.
+func ParseEmailStream(ctx context.Context, in <-chan string, opts ...Option) <-chan Result {
+	cfg := defaultConfig(opts...)
+
+	type job struct {
+		index int
+		email string
+	}
+
+	jobs := make(chan job)
+	out := make(chan Result)
+
+	// Dispatch
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case email, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case jobs <- job{i, email}:
+				}
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < cfg.numWorkers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				p, err := parse(ctx, j.email, &cfg)
+				select {
+				case <-ctx.Done():
+					return
+				case out <- Result{Index: j.index, ParsedEmail: p, Err: err}:
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
@@ -0,0 +1,75 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func testEmails(n int) []string {
+	emails := make([]string, n)
+	for i := range emails {
+		switch i % 3 {
+		case 0:
+			emails[i] = fmt.Sprintf("user%d@gmail.com", i)
+		case 1:
+			emails[i] = fmt.Sprintf("user%d@mailinator.com", i)
+		default:
+			emails[i] = fmt.Sprintf("invalid%d", i)
+		}
+	}
+	return emails
+}
+
+func TestParseEmails(t *testing.T) {
+	emails := testEmails(100)
+
+	for i, r := range ParseEmails(emails, WithWorkers(8)) {
+		if r.Index != i || r.ParsedEmail.Email != emails[i] {
+			t.Errorf("results[%d]: Index = %d, Email = %s, want %d, %s", i, r.Index, r.ParsedEmail.Email, i, emails[i])
+		}
+	}
+}
+
+func TestParseEmailStream(t *testing.T) {
+	emails := testEmails(1000)
+
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, email := range emails {
+			in <- email
+		}
+	}()
+
+	seen := make([]bool, len(emails))
+	for r := range ParseEmailStream(context.Background(), in, WithWorkers(8)) {
+		if r.Index < 0 || r.Index >= len(emails) {
+			t.Fatalf("Index %d out of range", r.Index)
+		}
+		if seen[r.Index] {
+			t.Errorf("Index %d returned more than once", r.Index)
+		}
+		seen[r.Index] = true
+
+		email := emails[r.Index]
+		switch r.Index % 3 {
+		case 0, 1:
+			if r.Err != nil || r.ParsedEmail.Email != email || r.ParsedEmail.Disposable != (r.Index%3 == 1) {
+				t.Errorf("Index %d: got %s (disposable: %v, err: %v), want %s", r.Index, r.ParsedEmail.Email, r.ParsedEmail.Disposable, r.Err, email)
+			}
+		default:
+			if r.Err == nil {
+				t.Errorf("Index %d: expected error for %s", r.Index, email)
+			}
+		}
+	}
+
+	for i, ok := range seen {
+		if !ok {
+			t.Errorf("Index %d missing", i)
+		}
+	}
+}
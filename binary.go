@@ -0,0 +1,144 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// binaryMagic identifies the compact binary list format.
+//
+// Format: magic | uvarint(count) | count x (uvarint(len) | domain)
+//
+// Domains are written in sorted order.
+var binaryMagic = []byte("ADE\x01")
+
+// ErrInvalidBinary is returned if a binary list is malformed.
+var ErrInvalidBinary = errors.New("invalid binary blocklist")
+
+// WriteBinary serializes domains into a compact binary format that can be read
+// quickly with ReadBinary.
+func WriteBinary(w io.Writer, domains map[string]struct{}) error {
+
+	sorted := make([]string, 0, len(domains))
+	for domain := range domains {
+		sorted = append(sorted, domain)
+	}
+	sort.Strings(sorted)
+
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.Write(binaryMagic); err != nil {
+		return err
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	n := binary.PutUvarint(buf, uint64(len(sorted)))
+	if _, err := bw.Write(buf[:n]); err != nil {
+		return err
+	}
+
+	for _, domain := range sorted {
+		n := binary.PutUvarint(buf, uint64(len(domain)))
+		if _, err := bw.Write(buf[:n]); err != nil {
+			return err
+		}
+		if _, err := bw.WriteString(domain); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ReadBinary reads domains in the format produced by WriteBinary. The input is read
+// into a single string that the domains share, so only the map is allocated per domain.
+func ReadBinary(r io.Reader) (map[string]struct{}, error) {
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(b, binaryMagic) {
+		return nil, ErrInvalidBinary
+	}
+	data := string(b[len(binaryMagic):])
+
+	count, n := binary.Uvarint(b[len(binaryMagic):])
+	if n <= 0 {
+		return nil, ErrInvalidBinary
+	}
+	pos := n
+
+	// Don't trust count for the allocation size
+	capacity := count
+	if capacity > 1<<16 {
+		capacity = 1 << 16
+	}
+	domains := make(map[string]struct{}, capacity)
+
+	for i := uint64(0); i < count; i++ {
+		l, n := binary.Uvarint(b[len(binaryMagic)+pos:])
+		if n <= 0 || l > maxDomainLen || uint64(len(data)-pos-n) < l {
+			return nil, ErrInvalidBinary
+		}
+		pos += n
+		domains[data[pos:pos+int(l)]] = struct{}{}
+		pos += int(l)
+	}
+
+	return domains, nil
+}
+
+// Save writes the domains to path in the format produced by WriteBinary.
+// The file is replaced atomically.
+func (l *List) Save(path string) error {
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := WriteBinary(tmp, l.Load()); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadList creates a List from a file written by List.Save.
+//
+// Example:
+//
+//	if l, err := disposable.LoadList(path); err == nil {
+//		disposable.ActiveList.Store(l.Load())
+//	}
+func LoadList(path string) (*List, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	domains, err := ReadBinary(file)
+	if err != nil {
+		return nil, err
+	}
+	return NewList(domains), nil
+}
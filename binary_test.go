@@ -0,0 +1,62 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, DisposableList); err != nil {
+		t.Fatal(err)
+	}
+
+	domains, err := ReadBinary(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(domains) != len(DisposableList) {
+		t.Fatalf("got %d domains, want %d", len(domains), len(DisposableList))
+	}
+	for domain := range DisposableList {
+		if _, exists := domains[domain]; !exists {
+			t.Errorf("%s missing after round trip", domain)
+		}
+	}
+}
+
+func TestBinarySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.bin")
+
+	l := NewList(map[string]struct{}{"a.com": {}, "b.com": {}})
+	if err := l.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Len() != 2 || !loaded.Contains("a.com") || !loaded.Contains("b.com") {
+		t.Errorf("got %v, want [a.com b.com]", loaded.Load())
+	}
+}
+
+func TestReadBinaryInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":     {},
+		"magic":     []byte("XYZ\x01\x01\x05a.com"),
+		"truncated": []byte("ADE\x01\x02\x05a.com\x05b."),
+		"too long":  append([]byte("ADE\x01\x01\xff\x7f"), bytes.Repeat([]byte("a"), 300)...),
+	}
+
+	for name, data := range tests {
+		if _, err := ReadBinary(bytes.NewReader(data)); err != ErrInvalidBinary {
+			t.Errorf("%s: got %v, want ErrInvalidBinary", name, err)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package bulk scrubs CSV and NDJSON streams of email addresses.
+//
+// Records are processed one at a time, so memory usage is bounded irrespective of the
+// size of the input. Each record is either annotated with the result of the check, or
+// dropped if it fails the check (see WithFilter).
+package bulk
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// Names of the columns (CSV) or fields (NDJSON) added to each record when annotating.
+const (
+	FieldValid      = "email_valid"
+	FieldDisposable = "email_disposable"
+	FieldNormalized = "email_normalized"
+)
+
+// ErrColumnNotFound is returned if the email column does not exist in the CSV header.
+var ErrColumnNotFound = errors.New("column not found")
+
+// Stats reports the progress of a scrub.
+type Stats struct {
+	// Processed is the number of records read.
+	Processed int
+
+	// Written is the number of records written.
+	Written int
+
+	// Invalid is the number of records with an invalid email address.
+	Invalid int
+
+	// Disposable is the number of records with a disposable email address.
+	Disposable int
+}
+
+// Option configures a scrub.
+type Option func(*config)
+
+type config struct {
+	column        string
+	filter        bool
+	parseOpts     []disposable.Option
+	progress      func(Stats)
+	progressEvery int
+	maxLineSize   int
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		column:        "email",
+		progressEvery: 1000,
+		maxLineSize:   1 << 20,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithColumn sets the CSV column (name or 0-based index) or NDJSON field containing
+// the email address. The default is "email".
+func WithColumn(column string) Option {
+	return func(cfg *config) {
+		cfg.column = column
+	}
+}
+
+// WithFilter drops records whose email address is invalid or disposable, instead of
+// annotating them.
+func WithFilter() Option {
+	return func(cfg *config) {
+		cfg.filter = true
+	}
+}
+
+// WithParseOptions sets the options passed to disposable.ParseEmail.
+func WithParseOptions(opts ...disposable.Option) Option {
+	return func(cfg *config) {
+		cfg.parseOpts = opts
+	}
+}
+
+// WithProgress calls fn after every n records and once at the end.
+func WithProgress(n int, fn func(Stats)) Option {
+	return func(cfg *config) {
+		cfg.progressEvery = n
+		cfg.progress = fn
+	}
+}
+
+// WithMaxLineSize sets the maximum size of an NDJSON line. The default is 1 MiB.
+func WithMaxLineSize(n int) Option {
+	return func(cfg *config) {
+		cfg.maxLineSize = n
+	}
+}
+
+type verdict struct {
+	valid      bool
+	disposable bool
+	normalized string
+}
+
+func (cfg *config) check(email string, stats *Stats) (verdict, bool) {
+	stats.Processed++
+
+	p, err := disposable.ParseEmail(email, cfg.parseOpts...)
+	v := verdict{valid: err == nil, disposable: p.Disposable}
+	if err != nil {
+		stats.Invalid++
+	} else {
+		v.normalized = p.Normalized + "@" + p.Domain
+		if p.Disposable {
+			stats.Disposable++
+		}
+	}
+
+	keep := !cfg.filter || (v.valid && !v.disposable)
+	if keep {
+		stats.Written++
+	}
+	return v, keep
+}
+
+func (cfg *config) report(stats Stats, final bool) {
+	if cfg.progress == nil {
+		return
+	}
+	if final || (cfg.progressEvery > 0 && stats.Processed%cfg.progressEvery == 0) {
+		cfg.progress(stats)
+	}
+}
+
+// CSV reads CSV records from r and writes them to w. The first record must be a header,
+// unless the column is specified by index. Unless WithFilter is used, the columns
+// email_valid, email_disposable and email_normalized are appended to each record.
+func CSV(r io.Reader, w io.Writer, opts ...Option) (Stats, error) {
+	cfg := newConfig(opts)
+
+	var stats Stats
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	cw := csv.NewWriter(w)
+
+	idx, err := strconv.Atoi(cfg.column)
+	if err != nil {
+		header, err := cr.Read()
+		if err != nil {
+			return stats, err
+		}
+
+		idx = -1
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), cfg.column) {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			return stats, fmt.Errorf("%w: %s", ErrColumnNotFound, cfg.column)
+		}
+
+		if !cfg.filter {
+			header = append(header, FieldValid, FieldDisposable, FieldNormalized)
+		}
+		if err := cw.Write(header); err != nil {
+			return stats, err
+		}
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stats, err
+		}
+
+		var email string
+		if idx < len(record) {
+			email = record[idx]
+		}
+
+		v, keep := cfg.check(email, &stats)
+		if keep {
+			if !cfg.filter {
+				record = append(record, strconv.FormatBool(v.valid), strconv.FormatBool(v.disposable), v.normalized)
+			}
+			if err := cw.Write(record); err != nil {
+				return stats, err
+			}
+		}
+		cfg.report(stats, false)
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return stats, err
+	}
+
+	cfg.report(stats, true)
+	return stats, nil
+}
+
+// NDJSON reads newline-delimited JSON objects from r and writes them to w. Unless WithFilter
+// is used, the fields FieldValid, FieldDisposable and FieldNormalized are added to each object.
+//
+// NOTE: When annotating, the fields of each object are re-encoded in sorted order.
+func NDJSON(r io.Reader, w io.Writer, opts ...Option) (Stats, error) {
+	cfg := newConfig(opts)
+
+	var stats Stats
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), cfg.maxLineSize)
+	bw := bufio.NewWriter(w)
+
+	var lineNum int // blank lines are skipped, so it can differ from stats.Processed
+	for scanner.Scan() {
+		lineNum++
+
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(line, &obj); err != nil {
+			return stats, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+
+		var email string
+		json.Unmarshal(obj[cfg.column], &email)
+
+		v, keep := cfg.check(email, &stats)
+		if keep {
+			out := line
+			if !cfg.filter {
+				obj[FieldValid], _ = json.Marshal(v.valid)
+				obj[FieldDisposable], _ = json.Marshal(v.disposable)
+				obj[FieldNormalized], _ = json.Marshal(v.normalized)
+
+				var err error
+				out, err = json.Marshal(obj)
+				if err != nil {
+					return stats, err
+				}
+			}
+			if _, err := bw.Write(out); err != nil {
+				return stats, err
+			}
+			if err := bw.WriteByte('\n'); err != nil {
+				return stats, err
+			}
+		}
+		cfg.report(stats, false)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return stats, err
+	}
+
+	if err := bw.Flush(); err != nil {
+		return stats, err
+	}
+
+	cfg.report(stats, true)
+	return stats, nil
+}
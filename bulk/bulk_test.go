@@ -0,0 +1,153 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package bulk
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCSV(t *testing.T) {
+	input := "name,Email\n" +
+		"John,John.Smith+news@gmail.com\n" +
+		"Jane,jane@mailinator.com\n" +
+		"Bob,not an email\n"
+
+	tests := []struct {
+		name  string
+		opts  []Option
+		want  string
+		stats Stats
+	}{
+		{
+			"annotate",
+			nil,
+			"name,Email,email_valid,email_disposable,email_normalized\n" +
+				"John,John.Smith+news@gmail.com,true,false,johnsmith@gmail.com\n" +
+				"Jane,jane@mailinator.com,true,true,jane@mailinator.com\n" +
+				"Bob,not an email,false,false,\n",
+			Stats{Processed: 3, Written: 3, Invalid: 1, Disposable: 1},
+		},
+		{
+			"filter",
+			[]Option{WithFilter()},
+			"name,Email\n" +
+				"John,John.Smith+news@gmail.com\n",
+			Stats{Processed: 3, Written: 1, Invalid: 1, Disposable: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		stats, err := CSV(strings.NewReader(input), &out, tt.opts...)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if out.String() != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, out.String(), tt.want)
+		}
+		if stats != tt.stats {
+			t.Errorf("%s: got %+v, want %+v", tt.name, stats, tt.stats)
+		}
+	}
+}
+
+func TestCSVColumnIndex(t *testing.T) {
+	var out bytes.Buffer
+	_, err := CSV(strings.NewReader("John,john@mailinator.com\n"), &out, WithColumn("1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "John,john@mailinator.com,true,true,john@mailinator.com\n"; out.String() != want {
+		t.Errorf("got %q, want %q", out.String(), want)
+	}
+}
+
+func TestCSVColumnNotFound(t *testing.T) {
+	_, err := CSV(strings.NewReader("name,address\n"), &bytes.Buffer{})
+	if !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("got %v, want ErrColumnNotFound", err)
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	input := `{"name":"John","email":"John.Smith+news@gmail.com"}` + "\n" +
+		"\n" +
+		`{"name":"Jane","email":"jane@mailinator.com"}` + "\n" +
+		`{"name":"Bob"}` + "\n"
+
+	tests := []struct {
+		name  string
+		opts  []Option
+		want  string
+		stats Stats
+	}{
+		{
+			"annotate",
+			nil,
+			`{"email":"John.Smith+news@gmail.com","email_disposable":false,"email_normalized":"johnsmith@gmail.com","email_valid":true,"name":"John"}` + "\n" +
+				`{"email":"jane@mailinator.com","email_disposable":true,"email_normalized":"jane@mailinator.com","email_valid":true,"name":"Jane"}` + "\n" +
+				`{"email_disposable":false,"email_normalized":"","email_valid":false,"name":"Bob"}` + "\n",
+			Stats{Processed: 3, Written: 3, Invalid: 1, Disposable: 1},
+		},
+		{
+			"filter",
+			[]Option{WithFilter()},
+			`{"name":"John","email":"John.Smith+news@gmail.com"}` + "\n",
+			Stats{Processed: 3, Written: 1, Invalid: 1, Disposable: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		stats, err := NDJSON(strings.NewReader(input), &out, tt.opts...)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if out.String() != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, out.String(), tt.want)
+		}
+		if stats != tt.stats {
+			t.Errorf("%s: got %+v, want %+v", tt.name, stats, tt.stats)
+		}
+	}
+}
+
+func TestNDJSONErrorLine(t *testing.T) {
+	input := `{"email":"john@gmail.com"}` + "\n" +
+		"\n" +
+		"\n" +
+		"not json\n"
+
+	_, err := NDJSON(strings.NewReader(input), &bytes.Buffer{})
+	if err == nil || !strings.HasPrefix(err.Error(), "line 4:") {
+		t.Errorf("got %v, want error on line 4", err)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	input := strings.Repeat(`{"email":"john@gmail.com"}`+"\n", 5)
+
+	var got []Stats
+	_, err := NDJSON(strings.NewReader(input), &bytes.Buffer{}, WithProgress(2, func(s Stats) {
+		got = append(got, s)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// After every 2 records and once at the end
+	want := []int{2, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("got %d progress reports, want %d", len(got), len(want))
+	}
+	for i, s := range got {
+		if s.Processed != want[i] {
+			t.Errorf("report %d: Processed = %d, want %d", i, s.Processed, want[i])
+		}
+	}
+}
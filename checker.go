@@ -0,0 +1,82 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Checker parses email addresses using its own disposable list, allow/deny lists
+// and normalization configuration. Unlike ParseEmail, which uses the package-level
+// ActiveList, multiple differently-configured Checkers can be used concurrently.
+//
+// A Checker is safe for concurrent use.
+type Checker struct {
+	cfg  config
+	list atomic.Value // lookupBox
+}
+
+// lookupBox allows different Lookup implementations to be stored in an atomic.Value.
+type lookupBox struct {
+	Lookup
+}
+
+// NewChecker creates a new Checker. Unless WithList is provided, the Checker
+// starts with the domains currently in ActiveList, but is not affected by later
+// updates to ActiveList.
+//
+// To keep a Checker's list up-to-date, provide a *List with WithList and update
+// it using the 'update' sub-package.
+func NewChecker(opts ...Option) *Checker {
+	cfg := newConfig()
+	cfg.list = NewList(ActiveList.Load())
+	cfg.apply(opts)
+
+	c := &Checker{cfg: cfg}
+	c.list.Store(lookupBox{cfg.list})
+	return c
+}
+
+func (c *Checker) config() config {
+	cfg := c.cfg
+	cfg.list = c.List()
+	return cfg
+}
+
+// Parse parses a given email address. opts are applied on top of the Checker's
+// configuration for this call only. See ParseEmail.
+func (c *Checker) Parse(email string, opts ...Option) (ParsedEmail, error) {
+	return c.ParseContext(context.Background(), email, opts...)
+}
+
+// ParseContext is the same as Parse, but ctx is used for network-backed checks.
+// See ParseEmailContext.
+func (c *Checker) ParseContext(ctx context.Context, email string, opts ...Option) (ParsedEmail, error) {
+	cfg := c.config()
+	cfg.apply(opts)
+	return parse(ctx, email, &cfg)
+}
+
+// IsDisposable returns true if domain is considered disposable by the Checker.
+// If a detector fails (see WithDetectors), the domain is not considered disposable.
+func (c *Checker) IsDisposable(domain string) bool {
+	domain, err := asciiDomain(domain)
+	if err != nil {
+		return false
+	}
+
+	cfg := c.config()
+	disposable, _ := cfg.detect(context.Background(), domain)
+	return disposable
+}
+
+// Reload replaces the Checker's disposable list with list.
+func (c *Checker) Reload(list Lookup) {
+	c.list.Store(lookupBox{list})
+}
+
+// List returns the Checker's disposable list.
+func (c *Checker) List() Lookup {
+	return c.list.Load().(lookupBox).Lookup
+}
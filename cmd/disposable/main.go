@@ -0,0 +1,249 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Command disposable checks email addresses for disposable email services.
+//
+// Usage:
+//
+//	disposable [flags] [email ...]
+//
+// If no emails are provided as arguments, they are read from stdin (one per line).
+// With -csv, stdin is read as CSV and the email address is taken from the -column column.
+//
+// Exit codes:
+//
+//	0: all email addresses are valid and not disposable
+//	1: at least one email address is invalid or disposable
+//	2: usage or I/O error
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+type result struct {
+	Email      string `json:"email"`
+	Valid      bool   `json:"valid"`
+	Disposable bool   `json:"disposable"`
+	Normalized string `json:"normalized,omitempty"`
+	Domain     string `json:"domain,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (r result) ok() bool {
+	return r.Valid && !r.Disposable
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	fs := flag.NewFlagSet("disposable", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	format := fs.String("format", "text", "output format: text, json or csv")
+	isCSV := fs.Bool("csv", false, "read stdin as CSV")
+	column := fs.String("column", "email", "CSV column containing the email address (name or 0-based index)")
+	filter := fs.Bool("filter", false, "only output email addresses that are valid and not disposable")
+	strict := fs.Bool("strict", false, "enable strict RFC 5321 validation")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	var opts []disposable.Option
+	if *strict {
+		opts = append(opts, disposable.WithStrictValidation())
+	}
+
+	w, err := newWriter(*format, stdout)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	exit := 0
+	emit := func(email string) error {
+		r := check(email, opts)
+		if !r.ok() {
+			exit = 1
+			if *filter {
+				return nil
+			}
+		}
+		return w.write(r)
+	}
+
+	switch {
+	case fs.NArg() > 0:
+		for _, email := range fs.Args() {
+			if err := emit(email); err != nil {
+				fmt.Fprintln(stderr, err)
+				return 2
+			}
+		}
+	case *isCSV:
+		err = readCSV(stdin, *column, emit)
+	default:
+		err = readLines(stdin, emit)
+	}
+
+	if err == nil {
+		err = w.flush()
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	return exit
+}
+
+func check(email string, opts []disposable.Option) result {
+	p, err := disposable.ParseEmail(email, opts...)
+	if err != nil {
+		return result{Email: strings.TrimSpace(email), Error: err.Error()}
+	}
+	return result{
+		Email:      p.Email,
+		Valid:      true,
+		Disposable: p.Disposable,
+		Normalized: p.Normalized + "@" + p.Domain,
+		Domain:     p.Domain,
+	}
+}
+
+func readLines(r io.Reader, emit func(string) error) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := emit(line); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func readCSV(r io.Reader, column string, emit func(string) error) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	idx, err := strconv.Atoi(column)
+	if err != nil {
+		// Find column by name in the header
+		header, err := cr.Read()
+		if err != nil {
+			return err
+		}
+		idx = -1
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), column) {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			return fmt.Errorf("column not found: %s", column)
+		}
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if idx >= len(record) {
+			continue
+		}
+		if err := emit(record[idx]); err != nil {
+			return err
+		}
+	}
+}
+
+type writer interface {
+	write(r result) error
+	flush() error
+}
+
+func newWriter(format string, w io.Writer) (writer, error) {
+	switch format {
+	case "text":
+		return &textWriter{w: bufio.NewWriter(w)}, nil
+	case "json":
+		return &jsonWriter{w: w}, nil
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"email", "valid", "disposable", "normalized", "domain", "error"}); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw}, nil
+	}
+	return nil, fmt.Errorf("unknown format: %s", format)
+}
+
+type textWriter struct {
+	w *bufio.Writer
+}
+
+func (t *textWriter) write(r result) error {
+	var status string
+	switch {
+	case !r.Valid:
+		status = "invalid (" + r.Error + ")"
+	case r.Disposable:
+		status = "disposable"
+	default:
+		status = "ok"
+	}
+	_, err := fmt.Fprintf(t.w, "%s\t%s\t%s\n", r.Email, status, r.Normalized)
+	return err
+}
+
+func (t *textWriter) flush() error {
+	return t.w.Flush()
+}
+
+// jsonWriter outputs newline-delimited JSON.
+type jsonWriter struct {
+	w io.Writer
+}
+
+func (j *jsonWriter) write(r result) error {
+	return json.NewEncoder(j.w).Encode(r)
+}
+
+func (j *jsonWriter) flush() error {
+	return nil
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) write(r result) error {
+	return c.w.Write([]string{r.Email, strconv.FormatBool(r.Valid), strconv.FormatBool(r.Disposable), r.Normalized, r.Domain, r.Error})
+}
+
+func (c *csvWriter) flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,131 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Command genlist regenerates the bundled list of disposable email domains (list.go).
+//
+// It downloads the upstream list, sorts and deduplicates it, and writes a Go source file
+// containing the DisposableList map along with the time it was generated and the commit
+// hash of the source.
+//
+// It is invoked via go:generate from the root package:
+//
+//	go generate github.com/rocketlaunchr/anti-disposable-email
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/rocketlaunchr/anti-disposable-email/update"
+)
+
+var tmpl = template.Must(template.New("list").Parse(`// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Code generated by genlist. DO NOT EDIT.
+
+package disposable
+
+// DisposableList is the bundled list of domains that are considered to be
+// from disposable email service providers. See: https://github.com/martenson/disposable-email-domains.
+// It is used to initialize ActiveList.
+//
+// Generated: {{.Generated}}
+// Source: {{.Source}}
+//
+// NOTE: To update ActiveList, refer to the 'update' sub-package.
+var DisposableList = map[string]struct{}{
+{{- range .Domains}}
+	{{printf "%q" .}}: {},
+{{- end}}
+}
+`))
+
+func main() {
+
+	out := flag.String("o", "list.go", "output file")
+	src := flag.String("src", "git", "source of the list: git, http or a file path")
+	timeout := flag.Duration("timeout", 2*time.Minute, "download timeout")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	var (
+		source update.Source
+		desc   string
+	)
+
+	switch *src {
+	case "git":
+		source, desc = update.GitSource{}, "https://github.com/martenson/disposable-email-domains"
+	case "http":
+		source, desc = update.HTTPSource{}, update.RawURL
+	default:
+		source, desc = update.FileSource(*src), *src
+	}
+
+	list, version, err := source.Fetch(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(list) == 0 {
+		log.Fatal("empty list")
+	}
+
+	domains := make([]string, 0, len(list))
+	for domain := range list {
+		domains = append(domains, strings.ToLower(domain))
+	}
+	sort.Strings(domains)
+	domains = dedupe(domains)
+
+	if version != "" {
+		desc += fmt.Sprintf(" (%s)", version)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Generated string
+		Source    string
+		Domains   []string
+	}{
+		Generated: time.Now().UTC().Format(time.RFC3339),
+		Source:    desc,
+		Domains:   domains,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Fprintf(os.Stderr, "genlist: wrote %d domains to %s\n", len(domains), *out)
+}
+
+// dedupe removes adjacent duplicates from a sorted slice.
+func dedupe(s []string) []string {
+	out := s[:0]
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,225 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"errors"
+)
+
+// Additional reasons returned by Decide.
+const (
+	ReasonInvalidEmail    = "invalid email"
+	ReasonListedDomain    = "listed domain"
+	ReasonSubdomainMatch  = "subdomain match"
+	ReasonNoMailServer    = "no mail server"
+	ReasonLookupFailed    = "lookup failed"
+	ReasonRoleAccount     = "role account"
+	ReasonTypoSuspected   = "typo suspected"
+	ReasonRelay           = "relay"
+	ReasonSuspicious      = "suspicious"
+	ReasonDetectorFailure = "detector failure"
+)
+
+// Action is the outcome of a Decision.
+type Action int
+
+// Actions ordered by severity.
+const (
+	Accept Action = iota
+	Review
+	Reject
+)
+
+// String implements the fmt.Stringer interface.
+func (a Action) String() string {
+	switch a {
+	case Accept:
+		return "accept"
+	case Review:
+		return "review"
+	case Reject:
+		return "reject"
+	}
+	return "unknown"
+}
+
+// Policy maps the reasons found for an email address to an Action.
+type Policy interface {
+	Action(reasons []string) Action
+}
+
+// ReasonPolicy is a Policy that maps each reason to an Action. The most severe Action of
+// all the reasons is used. Reasons that are not in the map result in Review.
+type ReasonPolicy map[string]Action
+
+// Action implements the Policy interface.
+func (rp ReasonPolicy) Action(reasons []string) Action {
+	action := Accept
+	for _, reason := range reasons {
+		a, exists := rp[reason]
+		if !exists {
+			a = Review
+		}
+		if a > action {
+			action = a
+		}
+	}
+	return action
+}
+
+// DefaultPolicy is used by Decide unless WithPolicy is provided. It rejects invalid and
+// disposable email addresses and flags borderline email addresses (including suspected
+// typos) for review. Failed DNS lookups are also flagged for review, since they are usually
+// transient.
+var DefaultPolicy Policy = ReasonPolicy{
+	ReasonInvalidEmail:    Reject,
+	ReasonInvalidDomain:   Reject,
+	ReasonNoMailServer:    Reject,
+	ReasonLookupFailed:    Review,
+	ReasonListedDomain:    Reject,
+	ReasonDisposable:      Reject,
+	ReasonSubdomainMatch:  Reject,
+	ReasonSpoofedProvider: Review,
+	ReasonSuspicious:      Review,
+	ReasonTypoSuspected:   Review,
+	ReasonDetectorFailure: Review,
+	ReasonRoleAccount:     Review,
+	ReasonRelay:           Accept,
+}
+
+// WithPolicy sets the Policy used by Decide. The default is DefaultPolicy.
+func WithPolicy(policy Policy) Option {
+	return func(cfg *config) {
+		cfg.policy = policy
+	}
+}
+
+// Decision is the result of Decide.
+type Decision struct {
+	// Action is the recommended action.
+	Action Action
+
+	// Reasons are machine-readable reason codes (e.g. ReasonListedDomain) that explain
+	// the Action. It is empty if nothing noteworthy was found.
+	Reasons []string
+
+	// ParsedEmail is the result of parsing the email address.
+	ParsedEmail ParsedEmail
+
+	// Err is the error returned when parsing the email address.
+	Err error
+}
+
+// Decide parses email and returns a Decision, instead of a single disposable flag. It allows
+// borderline email addresses (e.g. role accounts or suspected typos) to be flagged for review
+// rather than rejected outright. The reasons are mapped to an Action using DefaultPolicy,
+// unless WithPolicy is provided.
+//
+// Suggestions (see WithSuggestions) are always enabled, so misspelled domains are reported
+// as ReasonTypoSuspected, unless the policy is a ReasonPolicy that accepts them.
+//
+// Example:
+//
+//	d := disposable.Decide(ctx, email)
+//	if d.Action == disposable.Review {
+//		log.Println(d.Reasons)
+//	}
+func Decide(ctx context.Context, email string, opts ...Option) Decision {
+	cfg := defaultConfig(opts...)
+	return decide(ctx, email, &cfg)
+}
+
+// Decide parses email and returns a Decision. See the package-level Decide.
+func (c *Checker) Decide(ctx context.Context, email string, opts ...Option) Decision {
+	cfg := c.config()
+	cfg.apply(opts)
+	return decide(ctx, email, &cfg)
+}
+
+func decide(ctx context.Context, email string, cfg *config) Decision {
+	policy := cfg.policy
+	if policy == nil {
+		policy = DefaultPolicy
+	}
+
+	if checksTypos(policy) {
+		cfg.suggest = true
+	}
+
+	p, err := parse(ctx, email, cfg)
+
+	d := Decision{ParsedEmail: p, Err: err}
+
+	var (
+		vErr *ValidationError
+		dErr *DetectorError
+	)
+
+	switch {
+	case err == nil:
+	case errors.As(err, &dErr):
+		d.Reasons = append(d.Reasons, ReasonDetectorFailure)
+	case errors.Is(err, ErrNoMailServer):
+		d.Reasons = append(d.Reasons, ReasonNoMailServer)
+	case errors.As(err, &vErr) && vErr.Component == ComponentDomain:
+		d.Reasons = append(d.Reasons, ReasonInvalidDomain)
+	case errors.Is(err, ErrInvalidEmail):
+		d.Reasons = append(d.Reasons, ReasonInvalidEmail)
+	default:
+		// The domain could not be checked (e.g. a DNS timeout), which is usually transient
+		d.Reasons = append(d.Reasons, ReasonLookupFailed)
+	}
+
+	if p.Domain != "" {
+		if p.Disposable {
+			d.Reasons = append(d.Reasons, disposableReason(p, cfg))
+		} else if p.Score >= 0.5 {
+			d.Reasons = append(d.Reasons, ReasonSuspicious)
+		}
+
+		if p.SpoofedProvider {
+			d.Reasons = append(d.Reasons, ReasonSpoofedProvider)
+		}
+		if p.Suggestion != "" {
+			d.Reasons = append(d.Reasons, ReasonTypoSuspected)
+		}
+		if p.Role {
+			d.Reasons = append(d.Reasons, ReasonRoleAccount)
+		}
+		if p.Relay {
+			d.Reasons = append(d.Reasons, ReasonRelay)
+		}
+	}
+
+	d.Action = policy.Action(d.Reasons)
+
+	return d
+}
+
+// checksTypos returns false if policy is known to accept ReasonTypoSuspected, so the
+// cost of finding suggestions can be avoided.
+func checksTypos(policy Policy) bool {
+	rp, ok := policy.(ReasonPolicy)
+	if !ok {
+		return true
+	}
+	action, exists := rp[ReasonTypoSuspected]
+	return !exists || action != Accept
+}
+
+// disposableReason returns why a disposable email address was flagged.
+func disposableReason(p ParsedEmail, cfg *config) string {
+	exact := *cfg
+	exact.exactMatch = true
+	if disposable, listed := exact.lookup(p.Domain); listed && disposable {
+		return ReasonListedDomain
+	}
+
+	if disposable, listed := cfg.lookup(p.Domain); listed && disposable {
+		return ReasonSubdomainMatch
+	}
+
+	// Flagged by a Detector or a LocalPartRule
+	return ReasonDisposable
+}
@@ -0,0 +1,43 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestDecide(t *testing.T) {
+	r, _ := newTestResolver(t)
+	mx := []Option{WithMXVerification(), WithResolver(r)}
+
+	tests := []struct {
+		email   string
+		opts    []Option
+		action  Action
+		reasons []string
+	}{
+		{"john.smith@gmail.com", nil, Accept, nil},
+		{"not an email", nil, Reject, []string{ReasonInvalidEmail}},
+		{"john@b..com", nil, Reject, []string{ReasonInvalidDomain}},
+		{"john@mailinator.com", nil, Reject, []string{ReasonListedDomain}},
+		{"john@mx.mailinator.com", nil, Reject, []string{ReasonSubdomainMatch}},
+		{"admin@acme-corp.com", nil, Review, []string{ReasonRoleAccount}},
+		{"john@gamil.com", nil, Review, []string{ReasonTypoSuspected}},
+		{"john@gamil.com", []Option{WithSuggestions()}, Review, []string{ReasonTypoSuspected}},
+		{"john@gamil.com", []Option{WithPolicy(ReasonPolicy{ReasonTypoSuspected: Accept})}, Accept, nil},
+		{"john@privaterelay.appleid.com", nil, Accept, []string{ReasonRelay}},
+		{"john@live-domain.com", mx, Accept, nil},
+		{"john@dead-domain.com", mx, Reject, []string{ReasonNoMailServer}},
+		{"john@fail-domain.com", mx, Review, []string{ReasonLookupFailed}},
+		{"john@mailinator.com", []Option{WithPolicy(ReasonPolicy{ReasonListedDomain: Accept})}, Accept, []string{ReasonListedDomain}},
+	}
+
+	for _, tt := range tests {
+		d := Decide(context.Background(), tt.email, tt.opts...)
+		if d.Action != tt.action || !reflect.DeepEqual(d.Reasons, tt.reasons) {
+			t.Errorf("Decide(%q) = %v %v, want %v %v (err: %v)", tt.email, d.Action, d.Reasons, tt.action, tt.reasons, d.Err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "context"
+
+// Detector determines if a domain is disposable. It can be used to combine the bundled list
+// with other sources such as a remote API, a shared database or a heuristic.
+//
+// domain is lower-case and in punycode.
+type Detector interface {
+	IsDisposable(ctx context.Context, domain string) (bool, error)
+}
+
+// DetectorFunc is an adapter to allow the use of an ordinary function as a Detector.
+type DetectorFunc func(ctx context.Context, domain string) (bool, error)
+
+// IsDisposable implements the Detector interface.
+func (f DetectorFunc) IsDisposable(ctx context.Context, domain string) (bool, error) {
+	return f(ctx, domain)
+}
+
+// LookupDetector returns a Detector that checks if the domain is in l.
+func LookupDetector(l Lookup) Detector {
+	return DetectorFunc(func(ctx context.Context, domain string) (bool, error) {
+		return l.Contains(domain), nil
+	})
+}
+
+// WithDetectors sets a chain of detectors that are consulted, in order, if the domain
+// is not found in the allow, deny or disposable lists. The domain is disposable if any
+// detector returns true.
+//
+// If a detector fails, the remaining detectors are still consulted. If none of them
+// return true, the ParsedEmail is returned along with a *DetectorError.
+func WithDetectors(detectors ...Detector) Option {
+	return func(cfg *config) {
+		cfg.detectors = detectors
+	}
+}
+
+// DetectorError is returned if a Detector fails.
+type DetectorError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DetectorError) Error() string {
+	return "detector failed: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *DetectorError) Unwrap() error {
+	return e.Err
+}
+
+// detect returns true if domain is disposable according to the lists, followed by
+// the detectors.
+func (cfg *config) detect(ctx context.Context, domain string) (bool, error) {
+	disposable, listed := cfg.lookup(domain)
+	if listed {
+		return disposable, nil
+	}
+
+	var firstErr error
+	for _, d := range cfg.detectors {
+		ok, err := d.IsDisposable(ctx, domain)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = &DetectorError{Err: err}
+			}
+			continue
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, firstErr
+}
+
+// ignoreDetectorError returns nil if err is a *DetectorError. It is used where
+// the result of detection is irrelevant.
+func ignoreDetectorError(err error) error {
+	if _, ok := err.(*DetectorError); ok {
+		return nil
+	}
+	return err
+}
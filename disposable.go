@@ -2,15 +2,19 @@
 
 package disposable
 
+//go:generate go run ./cmd/genlist -o list.go
+
 import (
-	"errors"
+	"context"
 	"golang.org/x/net/idna"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
-// ErrInvalidEmail is returned if the email address is invalid.
-var ErrInvalidEmail = errors.New("invalid email")
+// SuspiciousShapeRatio is the ratio of the local-part length to the domain length
+// above which ParsedEmail.SuspiciousShape is set.
+var SuspiciousShapeRatio = 10.0
 
 // ParsedEmail returns a parsed email address.
 //
@@ -37,120 +41,397 @@ type ParsedEmail struct {
 	// Extra represents extra information that is domain specific.
 	//
 	// Example: gmail ignores all characters after the first '+' in the local-part.
+	// Yahoo does the same for the first '-'.
 	//
 	// adam+junk@gmail.com => adam@gmail.com (Extra: junk)
+	//
+	// For other domains, see SubaddressSeparators.
 	Extra string
 
+	// Tags represents the individual sub-address tags found in Extra.
+	//
+	// adam+news+2024@gmail.com => Extra: news+2024, Tags: [news 2024]
+	Tags []string
+
 	// Disposable is true if the email address is detected to be from
-	// a disposable email service.
+	// a disposable email service. Subdomains of disposable domains are also
+	// considered disposable.
 	//
 	// See: https://github.com/martenson/disposable-email-domains
 	Disposable bool
 
+	// Score is the confidence, between 0 and 1, that the email address is from a disposable
+	// email service. It is 1 if Disposable is true and 0 if the domain is in the allowlist.
+	// Otherwise it is calculated using heuristics that can flag disposable domains that are
+	// not yet in the list: suspicious top-level domains (see SuspiciousTLDs), random-looking
+	// or very short domain names and random-looking local-parts.
+	//
+	// NOTE: The heuristics are not authoritative. A score above 0.5 is a reasonable
+	// threshold for further review.
+	Score float64
+
+	// Relay is true if the email address is a relay (forwarding) address, such as those
+	// provided by privacy services (e.g. Apple Hide My Email or Firefox Relay).
+	// See LocalPartRules.
+	Relay bool
+
+	// FreeProvider is true if the email address is from a free webmail provider
+	// (e.g. gmail.com). This is a different question to disposability: it is useful for
+	// distinguishing corporate email addresses from personal ones.
+	//
+	// See FreeProviderList.
+	FreeProvider bool
+
+	// SpoofedProvider is true if the domain embeds a well-known free email provider
+	// below the registrable domain (eTLD+1) of a different domain. Regional provider
+	// domains (e.g. yahoo.com.br) are not flagged.
+	//
+	// Example: x@gmail.com.evil.tld
+	SpoofedProvider bool
+
+	// SuspiciousShape is true if the local-part is much longer than the domain.
+	// See SuspiciousShapeRatio.
+	//
+	// NOTE: This is a soft heuristic used by some anti-abuse systems. It is not
+	// an indication that the email address is invalid.
+	SuspiciousShape bool
+
+	// Role is true if the local-part belongs to a role or function (e.g. admin@ or noreply@)
+	// rather than a person. See RoleAccounts.
+	Role bool
+
+	// Suggestion is set if the domain appears to be a misspelling of a popular
+	// email domain (e.g. gamil.com => gmail.com). It is only set if WithSuggestions
+	// is used. See Suggest.
+	Suggestion string
+
 	// Domain represents the component after the '@' character.
-	// It is lower-cased since it's case-insensitive.
+	// It is lower-cased since it's case-insensitive. Internationalized domains
+	// are converted to punycode (e.g. bücher.de => xn--bcher-kva.de).
+	//
+	// If the domain is an alias of another domain (e.g. googlemail.com => gmail.com),
+	// Domain is the latter. See DomainAliases.
 	Domain string
 
+	// DomainUnicode represents Domain in its Unicode form (e.g. bücher.de).
+	// For ASCII-only domains, it is the same as Domain.
+	DomainUnicode string
+
+	// DomainLiteral is the IP address if the domain is an address literal
+	// (e.g. 192.168.1.1 for user@[192.168.1.1]). See WithDomainLiterals.
+	DomainLiteral string
+
 	// LocalPart represents the component before the '@' character.
 	LocalPart string
 }
 
-// ParseEmail parses a given email address. Set caseSensitive to true if you want the local-part
-// to be considered case-sensitive. The default value is false. Basic email validation is performed but
-// it is not comprehensively checked.
+// ParseEmail parses a given email address. Basic email validation is performed but
+// it is not comprehensively checked. Use WithStrictValidation for RFC 5321 validation.
+// Email addresses longer than 254 octets, local-parts longer than 64 octets and local-parts
+// containing control or invisible characters (e.g. zero-width spaces) are always rejected.
+// Local-parts containing white-space are also rejected, unless WithStrictValidation is used,
+// which permits white-space within a quoted-string (e.g. "john doe"@example.com).
+//
+// ParseEmail uses ActiveList, Allowlist and Denylist by default.
+//
+// If the email address is invalid, a *ValidationError is returned. It wraps ErrInvalidEmail.
+// If a Detector fails, the ParsedEmail is returned along with a *DetectorError.
+// Use ParseEmailContext if network-backed checks are used.
+//
+// Example:
+//
+//	p, err := disposable.ParseEmail(email, disposable.WithCaseSensitive(), disposable.WithStrictValidation())
 //
 // See https://github.com/badoux/checkmail for a more robust validation solution.
 //
 // See also https://davidcel.is/posts/stop-validating-email-addresses-with-regex.
-//
-func ParseEmail(email string, caseSensitive ...bool) (ParsedEmail, error) {
+func ParseEmail(email string, opts ...Option) (ParsedEmail, error) {
+	return ParseEmailContext(context.Background(), email, opts...)
+}
+
+// ParseEmailContext is the same as ParseEmail, but ctx is used for network-backed checks
+// such as Detectors and WithMXVerification, so they honor deadlines and cancellation.
+func ParseEmailContext(ctx context.Context, email string, opts ...Option) (ParsedEmail, error) {
+	cfg := defaultConfig(opts...)
+	return parse(ctx, email, &cfg)
+}
+
+func parse(ctx context.Context, email string, cfg *config) (ParsedEmail, error) {
+	p, err := parseEmail(ctx, email, cfg)
+	if cfg.metrics != nil {
+		cfg.metrics.Parsed(p, err)
+	}
+	return p, err
+}
+
+func parseEmail(ctx context.Context, email string, cfg *config) (ParsedEmail, error) {
 
 	// Perform basic validation
 	email = strings.TrimSpace(email)
 
 	if email == "" {
-		return ParsedEmail{}, ErrInvalidEmail
+		return ParsedEmail{}, invalid(ComponentEmail, email, ErrEmptyEmail)
+	}
+
+	if len(email) > maxEmailLen {
+		return ParsedEmail{Email: email}, invalid(ComponentEmail, email, ErrTooLong)
 	}
 
-	if strings.Contains(email, " ") {
-		return ParsedEmail{Email: email}, ErrInvalidEmail
+	var localPart, domain string
+
+	if cfg.strict {
+		// The local-part may contain '@' if it is quoted
+		idx := strings.LastIndexByte(email, '@')
+		if idx == -1 {
+			return ParsedEmail{Email: email}, invalid(ComponentEmail, email, ErrMissingAtSign)
+		}
+		localPart, domain = email[:idx], email[idx+1:]
+
+		if !validLocalPart(localPart) {
+			return ParsedEmail{Email: email}, invalid(ComponentLocalPart, localPart, ErrInvalidLocalPart)
+		}
+	} else {
+		idx := strings.IndexByte(email, '@')
+		switch {
+		case idx == -1:
+			return ParsedEmail{Email: email}, invalid(ComponentEmail, email, ErrMissingAtSign)
+		case strings.LastIndexByte(email, '@') != idx:
+			return ParsedEmail{Email: email}, invalid(ComponentEmail, email, ErrMultipleAtSigns)
+		}
+
+		localPart, domain = email[:idx], email[idx+1:]
+
+		if localPart == "" || len(localPart) > maxLocalPartLen || hasInvalidRunes(localPart) {
+			return ParsedEmail{Email: email}, invalid(ComponentLocalPart, localPart, ErrInvalidLocalPart)
+		}
 	}
 
-	var cs bool
-	if len(caseSensitive) > 0 {
-		cs = caseSensitive[0]
+	if hasInvalidRunes(domain) {
+		return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrInvalidDomain)
 	}
 
-	splits := strings.Split(email, "@")
-	if len(splits) != 2 {
-		return ParsedEmail{Email: email}, ErrInvalidEmail
+	// Check for a trailing port
+	if host, port := splitPort(domain); port != "" {
+		if !cfg.stripPort {
+			return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrDomainPort)
+		}
+		domain = host
 	}
 
-	domain := toLower(splits[1])
-	localPart := splits[0]
+	// Check for a domain literal
+	if strings.HasPrefix(domain, "[") {
+		if !cfg.literals {
+			return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrDomainLiteral)
+		}
+		literal, ip, ok := parseLiteral(domain)
+		if !ok {
+			return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrInvalidDomain)
+		}
+		return parseLiteralEmail(email, localPart, literal, ip, cfg), nil
+	}
 
-	domain, err := idna.ToASCII(domain)
+	asciiDomain, err := toASCII(strings.ToLower(domain))
 	if err != nil {
-		return ParsedEmail{Email: email}, ErrInvalidEmail
+		return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrInvalidDomain)
 	}
 
-	if !ValidateDomain(domain) {
-		return ParsedEmail{Email: email}, ErrInvalidEmail
+	if !ValidateDomain(asciiDomain) || cfg.strict && !validStrictDomain(asciiDomain) {
+		return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrInvalidDomain)
+	}
+
+	if cfg.psl {
+		if _, ok := RegistrableDomain(asciiDomain); !ok {
+			return ParsedEmail{Email: email}, invalid(ComponentDomain, domain, ErrInvalidDomain)
+		}
+	}
+	domain = asciiDomain
+
+	// Replace an alias with the domain it is an alias of
+	if alias, exists := cfg.aliases[domain]; exists {
+		domain = alias
 	}
 
 	p := ParsedEmail{
-		Email:     email,
-		Domain:    domain,
-		LocalPart: localPart,
+		Email:         email,
+		Domain:        domain,
+		DomainUnicode: domain,
+		LocalPart:     localPart,
+	}
+
+	if strings.Contains(domain, "xn--") {
+		if u, err := idna.ToUnicode(domain); err == nil {
+			p.DomainUnicode = u
+		}
 	}
 
 	// Normalize local part
-	p.Normalized, p.Preferred, p.Extra = normalize(localPart, domain, cs)
+	if cfg.noNormalize {
+		p.Normalized, p.Preferred = localPart, localPart
+	} else {
+		p.Normalized, p.Preferred, p.Extra, p.Tags = normalize(localPart, domain, cfg)
+	}
 
 	// Check if domain is disposable
-	_, p.Disposable = DisposableList[domain]
+	var detectErr error
+	p.Disposable, detectErr = cfg.detect(ctx, domain)
+
+	// Check if local-part matches a disposable or relay pattern
+	if disposable, relay := cfg.matchLocalPart(localPart, domain); disposable || relay {
+		p.Disposable = p.Disposable || disposable
+		p.Relay = relay
+	}
+
+	// Check if domain is a free webmail provider
+	p.FreeProvider = cfg.free.Contains(domain)
+
+	// Check if domain is impersonating a free email provider
+	p.SpoofedProvider = spoofedProvider(domain)
+
+	// Score how likely the domain is to be disposable
+	if p.Disposable {
+		p.Score = 1
+	} else if _, listed := cfg.lookup(domain); !listed {
+		p.Score = score(p)
+	}
+
+	// Check if local-part is a role account
+	p.Role = cfg.isRole(p.Preferred)
+
+	// Check if domain is a misspelling of a popular domain
+	if cfg.suggest {
+		p.Suggestion = suggest(domain, cfg.free)
+	}
 
-	return p, nil
+	// Check if local-part is unusually long relative to domain
+	p.SuspiciousShape = float64(len(localPart)) > SuspiciousShapeRatio*float64(len(domain))
+
+	// Check if domain can receive email
+	if cfg.verifyMX {
+		v := cfg.verifier
+		if v == nil {
+			v = DefaultVerifier
+		}
+
+		ok, err := v.Verify(ctx, domain)
+		if err != nil {
+			return p, err
+		}
+		if !ok {
+			return p, invalid(ComponentDomain, domain, ErrNoMailServer)
+		}
+	}
+
+	return p, detectErr
 
 }
 
-func normalize(localPart, domain string, caseSensitive bool) (ret string, pref string, sufx string) {
+// parseLiteralEmail returns the ParsedEmail for an email address with a domain literal.
+// The domain-based checks are not performed.
+func parseLiteralEmail(email, localPart, literal, ip string, cfg *config) ParsedEmail {
+	p := ParsedEmail{
+		Email:         email,
+		Domain:        literal,
+		DomainUnicode: literal,
+		DomainLiteral: ip,
+		LocalPart:     localPart,
+	}
+
+	if cfg.noNormalize {
+		p.Normalized, p.Preferred = localPart, localPart
+	} else {
+		p.Normalized, p.Preferred, p.Extra, p.Tags = normalize(localPart, literal, cfg)
+	}
+	p.Role = cfg.isRole(p.Preferred)
+
+	return p
+}
+
+func normalize(localPart, domain string, cfg *config) (ret string, pref string, sufx string, tags []string) {
 	pref = localPart
 
-	switch domain {
-	case "gmail.com":
-		// remove suffix from localPart
-		splits := strings.SplitN(localPart, "+", 2)
-		if len(splits) == 2 {
-			localPart, sufx = splits[0], splits[1]
+	rule, known := providerRules[domain]
+
+	// remove suffix from localPart
+	var sep string
+	if separators := cfg.tagSeparators(domain, rule.tagSeparators, known); separators != "" {
+		if idx := strings.IndexAny(localPart, separators); idx > 0 {
+			_, size := utf8.DecodeRuneInString(localPart[idx:])
+			localPart, sep, sufx = localPart[:idx], localPart[idx:idx+size], localPart[idx+size:]
 			pref = localPart
+			tags = splitTags(sufx, separators)
+		}
+	}
+
+	// remove the ignored characters
+	if rule.ignored != "" && strings.ContainsAny(localPart, rule.ignored) {
+		var b strings.Builder
+		b.Grow(len(localPart))
+		for _, r := range localPart {
+			if !strings.ContainsRune(rule.ignored, r) {
+				b.WriteRune(r)
+			}
 		}
+		localPart = b.String()
+	}
 
-		// remove the periods
-		localPart = strings.ReplaceAll(localPart, ".", "")
+	if cfg.keepTags && sep != "" {
+		localPart += sep + sufx
 	}
 
-	// lower-case the local part
-	if caseSensitive {
+	// lower-case the local part (known providers are always case-insensitive)
+	if cfg.caseSensitive && !known {
 		ret = localPart
 		return
 	}
 
-	ret = toLower(localPart)
+	ret = strings.ToLower(localPart)
 	return
 }
 
-func toLower(s string) (ret string) {
+// asciiDomain trims, lower-cases and converts domain to punycode.
+func asciiDomain(domain string) (string, error) {
+	return toASCII(strings.ToLower(strings.TrimSpace(domain)))
+}
+
+// toASCII converts domain to punycode. domain must be lower-case. Domains that
+// only contain a-z, 0-9, '-', '.' and '_' (and are not already punycode) are returned
+// as-is without the cost of idna.ToASCII.
+func toASCII(domain string) (string, error) {
+	for i := 0; i < len(domain); i++ {
+		c := domain[i]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_') {
+			return idna.ToASCII(domain)
+		}
+	}
+	if strings.Contains(domain, "xn--") {
+		return idna.ToASCII(domain)
+	}
+	return domain, nil
+}
+
+// hasInvalidRunes returns true if s contains invalid UTF-8, white-space, control characters or
+// invisible characters (e.g. zero-width spaces).
+func hasInvalidRunes(s string) bool {
 	for _, r := range s {
-		ret += string(unicode.ToLower(r))
+		if r == utf8.RuneError || unicode.IsSpace(r) || unicode.IsControl(r) || invisible(r) {
+			return true
+		}
 	}
-	return
+	return false
+}
+
+// invisible returns true if r is a formatting character that is not rendered
+// (e.g. zero-width space, zero-width joiner or byte order mark).
+func invisible(r rune) bool {
+	return unicode.Is(unicode.Cf, r)
 }
 
 // ValidateDomain returns true if the domain component of an email address is valid.
 // domain must be already lower-case and white-space trimmed. This function only performs a basic check and is not
 // authoritative. For domains containing unicode characters, you must perform punycode conversion beforehand.
 // See: https://godoc.org/golang.org/x/net/idna#ToASCII
+//
+// To also reject domains that do not have a registrable domain (e.g. foo.localhost), use RegistrableDomain.
 func ValidateDomain(domain string) bool {
 	if domain == "" {
 		return false
@@ -161,6 +442,11 @@ func ValidateDomain(domain string) bool {
 		return false
 	}
 
+	// Check for empty labels
+	if strings.Contains(domain, "..") {
+		return false
+	}
+
 	// Check if only a-z, 0-9, -, . and _ are found.
 	for _, r := range domain {
 		switch r {
@@ -183,8 +469,7 @@ func ValidateDomain(domain string) bool {
 	}
 
 	// Check number of characters after final dot is at least 2
-	splits := strings.Split(domain, ".")
-	if len(splits) > 1 && len(splits[len(splits)-1]) < 2 {
+	if idx := strings.LastIndexByte(domain, '.'); idx != -1 && len(domain)-idx-1 < 2 {
 		return false
 	}
 
@@ -0,0 +1,60 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSuspiciousShape(t *testing.T) {
+	tests := []struct {
+		email      string
+		suspicious bool
+	}{
+		{strings.Repeat("a", 60) + "@x.io", true},
+		{"john.smith@x.io", false},
+		{"john.smith@gmail.com", false},
+		{strings.Repeat("a", 60) + "@example.com", false},
+	}
+
+	for _, tt := range tests {
+		p, err := ParseEmail(tt.email)
+		if err != nil {
+			t.Errorf("%s: %v", tt.email, err)
+			continue
+		}
+		if p.SuspiciousShape != tt.suspicious {
+			t.Errorf("%s: SuspiciousShape = %v, want %v", tt.email, p.SuspiciousShape, tt.suspicious)
+		}
+	}
+}
+
+func benchmarkParseEmail(b *testing.B, email string, opts ...Option) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseEmail(email, opts...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseEmailCorporate(b *testing.B) {
+	benchmarkParseEmail(b, "john.smith@acme-corp.com")
+}
+
+func BenchmarkParseEmailGmail(b *testing.B) {
+	benchmarkParseEmail(b, "John.Smith+newsletter@gmail.com")
+}
+
+func BenchmarkParseEmailDisposable(b *testing.B) {
+	benchmarkParseEmail(b, "john@mailinator.com")
+}
+
+func BenchmarkParseEmailUnicode(b *testing.B) {
+	benchmarkParseEmail(b, "john@bücher.de")
+}
+
+func BenchmarkParseEmailSuggestions(b *testing.B) {
+	benchmarkParseEmail(b, "john.smith@acme-corp.com", WithSuggestions())
+}
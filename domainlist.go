@@ -0,0 +1,171 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"iter"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// ActiveList is the list of disposable domains used by ParseEmail and DomainVerdict.
+// It is initialized with DisposableList and can be updated at any time using the
+// 'update' sub-package.
+var ActiveList = NewList(DisposableList)
+
+// List is a set of domains that can be replaced while it is being read.
+// Reads never lock and replacing the domains is race-free.
+//
+// A List is safe for concurrent use.
+type List struct {
+	v atomic.Pointer[listData]
+}
+
+// listData is replaced as a whole, so the domains and their metadata are always consistent.
+type listData struct {
+	domains map[string]struct{}
+	updated time.Time
+	source  string
+}
+
+// ListInfo describes the contents of a List.
+type ListInfo struct {
+	// Len is the number of domains in the list.
+	Len int
+
+	// Updated is when the domains were last replaced (or when the List was created).
+	Updated time.Time
+
+	// Source identifies where the domains came from (e.g. the commit hash or ETag provided
+	// by the 'update' sub-package). It is empty if unknown (e.g. the bundled DisposableList).
+	Source string
+}
+
+// NewList creates a List containing domains. domains must not be modified afterwards.
+func NewList(domains map[string]struct{}) *List {
+	l := &List{}
+	l.Store(domains)
+	return l
+}
+
+func (l *List) data() *listData {
+	d := l.v.Load()
+	if d == nil {
+		return &listData{}
+	}
+	return d
+}
+
+// Contains returns true if domain is in the list.
+func (l *List) Contains(domain string) bool {
+	_, exists := l.Load()[domain]
+	return exists
+}
+
+// Len returns the number of domains in the list.
+func (l *List) Len() int {
+	return len(l.Load())
+}
+
+// Load returns the domains. The returned map must not be modified.
+func (l *List) Load() map[string]struct{} {
+	return l.data().domains
+}
+
+// Info returns the number of domains in the list along with when and where they came from.
+func (l *List) Info() ListInfo {
+	d := l.data()
+	return ListInfo{Len: len(d.domains), Updated: d.updated, Source: d.source}
+}
+
+// Domains returns an iterator over the domains currently in the list, in sorted order.
+//
+//	for domain := range disposable.ActiveList.Domains() {
+//		fmt.Println(domain)
+//	}
+//
+// The domains are captured when Domains is called, so later updates do not affect the iteration.
+func (l *List) Domains() iter.Seq[string] {
+	domains := l.Load()
+
+	sorted := make([]string, 0, len(domains))
+	for domain := range domains {
+		sorted = append(sorted, domain)
+	}
+	sort.Strings(sorted)
+
+	return func(yield func(string) bool) {
+		for _, domain := range sorted {
+			if !yield(domain) {
+				return
+			}
+		}
+	}
+}
+
+// Add adds domains to the list. They are lower-cased and converted to punycode.
+// It is safe to call while the list is being read.
+func (l *List) Add(domains ...string) {
+	l.update(func(m map[string]struct{}) {
+		for domain := range toSet(domains) {
+			m[domain] = struct{}{}
+		}
+	})
+}
+
+// Remove removes domains from the list. They are lower-cased and converted to punycode.
+// It is safe to call while the list is being read.
+func (l *List) Remove(domains ...string) {
+	l.update(func(m map[string]struct{}) {
+		for domain := range toSet(domains) {
+			delete(m, domain)
+		}
+	})
+}
+
+// update replaces the domains with a modified copy. Concurrent updates are retried,
+// so none are lost.
+func (l *List) update(fn func(m map[string]struct{})) {
+	for {
+		old := l.v.Load()
+
+		var source string
+		m := map[string]struct{}{}
+		if old != nil {
+			source = old.source
+			m = make(map[string]struct{}, len(old.domains))
+			for domain := range old.domains {
+				m[domain] = struct{}{}
+			}
+		}
+		fn(m)
+
+		if l.v.CompareAndSwap(old, &listData{domains: m, updated: time.Now(), source: source}) {
+			return
+		}
+	}
+}
+
+// Store replaces the domains. domains must not be modified afterwards.
+func (l *List) Store(domains map[string]struct{}) {
+	l.Swap(domains)
+}
+
+// Swap replaces the domains and returns the old domains.
+// domains must not be modified afterwards.
+func (l *List) Swap(domains map[string]struct{}) map[string]struct{} {
+	return l.SwapSource(domains, "")
+}
+
+// SwapSource is the same as Swap, but also records where the domains came from. See ListInfo.
+func (l *List) SwapSource(domains map[string]struct{}, source string) map[string]struct{} {
+	if domains == nil {
+		domains = map[string]struct{}{}
+	}
+	old := l.v.Swap(&listData{domains: domains, updated: time.Now(), source: source})
+	if old == nil {
+		return nil
+	}
+	return old.domains
+}
@@ -0,0 +1,38 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "context"
+
+// Equal returns true if p and other refer to the same mailbox. The Normalized
+// local-parts and the domains are compared. Provider aliases (e.g. googlemail.com is the
+// same as gmail.com) are already resolved in Domain when parsing. See WithDomainAliases.
+//
+// Both must have been parsed with the same options for the result to be meaningful.
+func (p ParsedEmail) Equal(other ParsedEmail) bool {
+	if p.Domain == "" || other.Domain == "" {
+		return false
+	}
+	return p.Normalized == other.Normalized && p.Domain == other.Domain
+}
+
+// Same returns true if email1 and email2 refer to the same mailbox. See ParsedEmail.Equal.
+//
+// Example:
+//
+//	disposable.Same("John.Smith+news@googlemail.com", "johnsmith@gmail.com") // true
+func Same(email1, email2 string, opts ...Option) (bool, error) {
+	cfg := defaultConfig(opts...)
+
+	p1, err := parse(context.Background(), email1, &cfg)
+	if err := ignoreDetectorError(err); err != nil {
+		return false, err
+	}
+
+	p2, err := parse(context.Background(), email2, &cfg)
+	if err := ignoreDetectorError(err); err != nil {
+		return false, err
+	}
+
+	return p1.Equal(p2), nil
+}
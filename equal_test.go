@@ -0,0 +1,40 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "testing"
+
+func TestSame(t *testing.T) {
+	tests := []struct {
+		email1, email2 string
+		opts           []Option
+		same           bool
+	}{
+		{"John.Smith+news@googlemail.com", "johnsmith@gmail.com", nil, true},
+		{"john@gmail.com", "john@GMAIL.com", nil, true},
+		{"john@gmail.com", "jane@gmail.com", nil, false},
+		{"john@googlemail.com", "john@gmail.com", []Option{WithDomainAliases(map[string]string{})}, false},
+		{"john@example.org", "john@example.com", []Option{WithDomainAliases(map[string]string{"example.org": "example.com"})}, true},
+	}
+
+	for _, tt := range tests {
+		same, err := Same(tt.email1, tt.email2, tt.opts...)
+		if err != nil {
+			t.Errorf("Same(%q, %q): unexpected error: %v", tt.email1, tt.email2, err)
+			continue
+		}
+		if same != tt.same {
+			t.Errorf("Same(%q, %q) = %v, want %v", tt.email1, tt.email2, same, tt.same)
+		}
+	}
+}
+
+func TestAddressStringAliases(t *testing.T) {
+	a, err := NewAddress("john@googlemail.com", WithDomainAliases(map[string]string{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := a.String(); got != "john@googlemail.com" {
+		t.Errorf("String() = %s, want john@googlemail.com", got)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidEmail is returned if the email address is invalid. All other
+// validation errors wrap it, so errors.Is(err, ErrInvalidEmail) can be used to
+// check for any validation error.
+var ErrInvalidEmail = errors.New("invalid email")
+
+var (
+	// ErrEmptyEmail is returned if the email address is empty.
+	ErrEmptyEmail = fmt.Errorf("%w: empty", ErrInvalidEmail)
+
+	// ErrTooLong is returned if the email address is longer than 254 octets.
+	ErrTooLong = fmt.Errorf("%w: too long", ErrInvalidEmail)
+
+	// ErrMissingAtSign is returned if the email address does not contain an '@' character.
+	ErrMissingAtSign = fmt.Errorf("%w: missing @", ErrInvalidEmail)
+
+	// ErrMultipleAtSigns is returned if the email address contains more than one '@' character
+	// outside of a quoted local-part.
+	ErrMultipleAtSigns = fmt.Errorf("%w: multiple @", ErrInvalidEmail)
+
+	// ErrInvalidLocalPart is returned if the local-part is invalid.
+	ErrInvalidLocalPart = fmt.Errorf("%w: invalid local-part", ErrInvalidEmail)
+
+	// ErrInvalidDomain is returned if the domain is invalid.
+	ErrInvalidDomain = fmt.Errorf("%w: invalid domain", ErrInvalidEmail)
+
+	// ErrDomainLiteral is returned if the domain is an address literal (e.g. [192.168.1.1])
+	// and WithDomainLiterals is not used.
+	ErrDomainLiteral = fmt.Errorf("%w: domain literal", ErrInvalidEmail)
+
+	// ErrDomainPort is returned if the domain has a trailing port (e.g. example.com:25)
+	// and WithPortStripping is not used.
+	ErrDomainPort = fmt.Errorf("%w: domain has port", ErrInvalidEmail)
+
+	// ErrNoMailServer is returned by WithMXVerification if the domain cannot receive email.
+	ErrNoMailServer = fmt.Errorf("%w: domain cannot receive email", ErrInvalidEmail)
+)
+
+// Component identifies a component of an email address.
+type Component string
+
+// Components of an email address.
+const (
+	ComponentEmail     Component = "email"
+	ComponentLocalPart Component = "local-part"
+	ComponentDomain    Component = "domain"
+)
+
+// ValidationError is returned by ParseEmail when the email address is invalid.
+// It unwraps to one of the sentinel errors above.
+//
+// Example:
+//
+//	var vErr *disposable.ValidationError
+//	if errors.As(err, &vErr) {
+//		fmt.Println(vErr.Component, vErr.Value)
+//	}
+type ValidationError struct {
+	// Component is the offending component of the email address.
+	Component Component
+
+	// Value is the offending value.
+	Value string
+
+	// Err is the sentinel error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v (%s: %q)", e.Err, e.Component, e.Value)
+}
+
+// Unwrap returns the sentinel error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func invalid(c Component, value string, err error) error {
+	return &ValidationError{Component: c, Value: value, Err: err}
+}
@@ -0,0 +1,103 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+// FreeProviderList is the bundled list of free webmail domains. Unlike disposable
+// email services, free webmail providers offer permanent mailboxes, but they do not
+// indicate that the user belongs to an organization. It is used to initialize
+// ActiveFreeProviderList.
+//
+// NOTE: To update ActiveFreeProviderList, refer to the 'update' sub-package.
+var FreeProviderList = map[string]struct{}{
+	"126.com":        {},
+	"163.com":        {},
+	"aim.com":        {},
+	"aol.com":        {},
+	"att.net":        {},
+	"bellsouth.net":  {},
+	"btinternet.com": {},
+	"comcast.net":    {},
+	"cox.net":        {},
+	"daum.net":       {},
+	"earthlink.net":  {},
+	"fastmail.com":   {},
+	"fastmail.fm":    {},
+	"free.fr":        {},
+	"freenet.de":     {},
+	"gmail.com":      {},
+	"gmx.at":         {},
+	"gmx.com":        {},
+	"gmx.de":         {},
+	"gmx.net":        {},
+	"googlemail.com": {},
+	"hanmail.net":    {},
+	"hey.com":        {},
+	"hotmail.co.uk":  {},
+	"hotmail.com":    {},
+	"hotmail.de":     {},
+	"hotmail.es":     {},
+	"hotmail.fr":     {},
+	"hotmail.it":     {},
+	"hushmail.com":   {},
+	"icloud.com":     {},
+	"inbox.ru":       {},
+	"laposte.net":    {},
+	"libero.it":      {},
+	"list.ru":        {},
+	"live.co.uk":     {},
+	"live.com":       {},
+	"live.fr":        {},
+	"mac.com":        {},
+	"mail.com":       {},
+	"mail.ru":        {},
+	"me.com":         {},
+	"msn.com":        {},
+	"naver.com":      {},
+	"orange.fr":      {},
+	"outlook.com":    {},
+	"outlook.de":     {},
+	"outlook.fr":     {},
+	"pm.me":          {},
+	"proton.me":      {},
+	"protonmail.ch":  {},
+	"protonmail.com": {},
+	"qq.com":         {},
+	"rambler.ru":     {},
+	"rediffmail.com": {},
+	"rocketmail.com": {},
+	"sbcglobal.net":  {},
+	"seznam.cz":      {},
+	"sina.com":       {},
+	"t-online.de":    {},
+	"tutanota.com":   {},
+	"verizon.net":    {},
+	"web.de":         {},
+	"wp.pl":          {},
+	"yahoo.ca":       {},
+	"yahoo.co.in":    {},
+	"yahoo.co.jp":    {},
+	"yahoo.co.uk":    {},
+	"yahoo.com":      {},
+	"yahoo.com.au":   {},
+	"yahoo.com.br":   {},
+	"yahoo.de":       {},
+	"yahoo.es":       {},
+	"yahoo.fr":       {},
+	"yahoo.it":       {},
+	"yandex.com":     {},
+	"yandex.ru":      {},
+	"ymail.com":      {},
+	"zoho.com":       {},
+	"zohomail.com":   {},
+}
+
+// ActiveFreeProviderList is the list of free webmail domains used by ParseEmail.
+// It is initialized with FreeProviderList.
+var ActiveFreeProviderList = NewList(FreeProviderList)
+
+// WithFreeProviderList sets the list of free webmail domains. The default is ActiveFreeProviderList.
+func WithFreeProviderList(list Lookup) Option {
+	return func(cfg *config) {
+		cfg.free = list
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// fuzzSeeds are inputs that previously slipped past validation or are likely to
+// exercise edge cases.
+var fuzzSeeds = []string{
+	"john.smith@gmail.com",
+	"John.Smith+news@googlemail.com",
+	"john\u200b@gmail.com",
+	"john@gm\u200bail.com",
+	"\ufeffjohn@gmail.com",
+	"john\xff@gmail.com",
+	"john@gmail\xc3.com",
+	"jo\x00hn@gmail.com",
+	"\"a@b\"@x.com",
+	"\"a\\\"b\"@x.com",
+	"x@[IPv6:::1]:25",
+	"x@[192.168.1.1]",
+	"x@example.com:25",
+	"x@bücher.de",
+	"x@xn--bcher-kva.de",
+	"x@b..com",
+	"@x.com",
+	strings.Repeat("a", 65) + "@x.com",
+	"a@" + strings.Repeat("b", 250) + ".com",
+	strings.Repeat("a@", 200),
+}
+
+func FuzzParseEmail(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed, false, false)
+		f.Add(seed, true, true)
+	}
+
+	f.Fuzz(func(t *testing.T, email string, strict, literals bool) {
+		var opts []Option
+		if strict {
+			opts = append(opts, WithStrictValidation())
+		}
+		if literals {
+			opts = append(opts, WithDomainLiterals(), WithPortStripping())
+		}
+
+		p, err := ParseEmail(email, opts...)
+		if err != nil {
+			var dErr *DetectorError
+			if !errors.Is(err, ErrInvalidEmail) && !errors.As(err, &dErr) {
+				t.Fatalf("unexpected error type: %v", err)
+			}
+			return
+		}
+
+		if len(p.Email) > maxEmailLen {
+			t.Errorf("accepted %d octet email address", len(p.Email))
+		}
+		if p.LocalPart == "" || len(p.LocalPart) > maxLocalPartLen {
+			t.Errorf("accepted %d octet local-part", len(p.LocalPart))
+		}
+		if !utf8.ValidString(p.Email) {
+			t.Errorf("accepted invalid UTF-8: %q", p.Email)
+		}
+		if !strict && hasInvalidRunes(p.LocalPart) {
+			t.Errorf("accepted invalid runes in local-part: %q", p.LocalPart)
+		}
+		if p.DomainLiteral == "" && !ValidateDomain(p.Domain) {
+			t.Errorf("accepted invalid domain: %q", p.Domain)
+		}
+	})
+}
+
+func FuzzValidateDomain(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		if idx := strings.LastIndexByte(seed, '@'); idx != -1 {
+			f.Add(seed[idx+1:])
+		}
+	}
+	f.Add("")
+	f.Add(".")
+	f.Add("-a.com")
+	f.Add("a-.com")
+	f.Add("a.c")
+
+	f.Fuzz(func(t *testing.T, domain string) {
+		if !ValidateDomain(domain) {
+			return
+		}
+
+		if strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") || strings.Contains(domain, "..") {
+			t.Errorf("accepted empty label: %q", domain)
+		}
+		if strings.HasPrefix(domain, "-") || strings.HasSuffix(domain, "-") {
+			t.Errorf("accepted leading or trailing hyphen: %q", domain)
+		}
+		for i := 0; i < len(domain); i++ {
+			c := domain[i]
+			if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_') {
+				t.Errorf("accepted %q in %q", c, domain)
+			}
+		}
+	})
+}
+
+func TestParseEmailRejections(t *testing.T) {
+	tests := []struct {
+		email string
+		opts  []Option
+		err   error
+	}{
+		{"", nil, ErrEmptyEmail},
+		{"   ", nil, ErrEmptyEmail},
+		{"a@" + strings.Repeat("b", 250) + ".com", nil, ErrTooLong},
+		{"john.gmail.com", nil, ErrMissingAtSign},
+		{"\"a@b\"@x.com", nil, ErrMultipleAtSigns},
+		{"@x.com", nil, ErrInvalidLocalPart},
+		{strings.Repeat("a", 65) + "@x.com", nil, ErrInvalidLocalPart},
+		{"john\u200b@gmail.com", nil, ErrInvalidLocalPart},
+		{"\ufeffjohn@gmail.com", nil, ErrInvalidLocalPart},
+		{"john\xff@gmail.com", nil, ErrInvalidLocalPart},
+		{"jo\x00hn@gmail.com", nil, ErrInvalidLocalPart},
+		{"jo hn@gmail.com", nil, ErrInvalidLocalPart},
+		{"john@gm\u200bail.com", nil, ErrInvalidDomain},
+		{"john@gmail\xc3.com", nil, ErrInvalidDomain},
+		{"john@b..com", nil, ErrInvalidDomain},
+		{"john@example.com:25", nil, ErrDomainPort},
+		{"x@[IPv6:::1]:25", nil, ErrDomainPort},
+		{"x@[IPv6:::1]:25", []Option{WithPortStripping()}, ErrDomainLiteral},
+		{"x@[not-an-ip]", []Option{WithDomainLiterals()}, ErrInvalidDomain},
+		{"\"a\xffb\"@x.com", []Option{WithStrictValidation()}, ErrInvalidLocalPart},
+		{"\"a\u200bb\"@x.com", []Option{WithStrictValidation()}, ErrInvalidLocalPart},
+		{"a..b@x.com", []Option{WithStrictValidation()}, ErrInvalidLocalPart},
+		{"a@" + strings.Repeat("b", 64) + ".com", []Option{WithStrictValidation()}, ErrInvalidDomain},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseEmail(tt.email, tt.opts...)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ParseEmail(%q) = %v, want %v", tt.email, err, tt.err)
+		}
+
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) {
+			t.Errorf("ParseEmail(%q) = %T, want *ValidationError", tt.email, err)
+		}
+	}
+}
+
+func TestParseEmailAccepted(t *testing.T) {
+	tests := []struct {
+		email string
+		opts  []Option
+	}{
+		{"\"a@b\"@x.com", []Option{WithStrictValidation()}},
+		{"x@[IPv6:::1]:25", []Option{WithDomainLiterals(), WithPortStripping()}},
+		{"x@[192.168.1.1]", []Option{WithDomainLiterals()}},
+		{"x@example.com:25", []Option{WithPortStripping()}},
+		{"jöhn@bücher.de", nil},
+		{strings.Repeat("a", 64) + "@x.com", nil},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseEmail(tt.email, tt.opts...); err != nil {
+			t.Errorf("ParseEmail(%q) = %v, want nil", tt.email, err)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"strings"
+)
+
+// GravatarHash returns the hash used by Gravatar to identify the email address.
+// It is the MD5 hex digest of the trimmed and lower-cased email address.
+//
+// NOTE: Gravatar uses the raw email address, so the hash is based on Email and not on
+// the Normalized local-part. This means john.smith@gmail.com and johnsmith@gmail.com
+// produce different hashes even though they are the same mailbox.
+//
+// See: https://docs.gravatar.com/general/hash
+func (p ParsedEmail) GravatarHash() string {
+	h := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(p.Email))))
+	return hex.EncodeToString(h[:])
+}
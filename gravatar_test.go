@@ -0,0 +1,26 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "testing"
+
+func TestGravatarHash(t *testing.T) {
+	// Example from https://docs.gravatar.com/general/hash
+	p, err := ParseEmail(" MyEmailAddress@example.com ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := p.GravatarHash(), "0bc83cb571cd1c50ba6f3e8a78ef1346"; got != want {
+		t.Errorf("GravatarHash() = %s, want %s", got, want)
+	}
+}
+
+func TestGravatarHashNotNormalized(t *testing.T) {
+	p1, _ := ParseEmail("john.smith@gmail.com")
+	p2, _ := ParseEmail("johnsmith@gmail.com")
+
+	if p1.GravatarHash() == p2.GravatarHash() {
+		t.Error("GravatarHash() must use the raw email address, not the normalized form")
+	}
+}
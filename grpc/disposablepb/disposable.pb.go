@@ -0,0 +1,681 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: disposable.proto
+
+package disposablepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Options alter how an email address is parsed.
+type Options struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// case_sensitive treats the local-part as case-sensitive.
+	CaseSensitive bool `protobuf:"varint,1,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
+	// strict enforces RFC 5321 validation.
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+	// suggestions sets ParsedEmail.suggestion for likely misspellings of popular domains.
+	Suggestions   bool `protobuf:"varint,3,opt,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	mi := &file_disposable_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Options) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+func (x *Options) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *Options) GetSuggestions() bool {
+	if x != nil {
+		return x.Suggestions
+	}
+	return false
+}
+
+// ParsedEmail mirrors disposable.ParsedEmail.
+type ParsedEmail struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Email           string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Preferred       string                 `protobuf:"bytes,2,opt,name=preferred,proto3" json:"preferred,omitempty"`
+	Normalized      string                 `protobuf:"bytes,3,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Extra           string                 `protobuf:"bytes,4,opt,name=extra,proto3" json:"extra,omitempty"`
+	Disposable      bool                   `protobuf:"varint,5,opt,name=disposable,proto3" json:"disposable,omitempty"`
+	SpoofedProvider bool                   `protobuf:"varint,6,opt,name=spoofed_provider,json=spoofedProvider,proto3" json:"spoofed_provider,omitempty"`
+	SuspiciousShape bool                   `protobuf:"varint,7,opt,name=suspicious_shape,json=suspiciousShape,proto3" json:"suspicious_shape,omitempty"`
+	Domain          string                 `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainUnicode   string                 `protobuf:"bytes,9,opt,name=domain_unicode,json=domainUnicode,proto3" json:"domain_unicode,omitempty"`
+	LocalPart       string                 `protobuf:"bytes,10,opt,name=local_part,json=localPart,proto3" json:"local_part,omitempty"`
+	Role            bool                   `protobuf:"varint,11,opt,name=role,proto3" json:"role,omitempty"`
+	FreeProvider    bool                   `protobuf:"varint,12,opt,name=free_provider,json=freeProvider,proto3" json:"free_provider,omitempty"`
+	Score           float64                `protobuf:"fixed64,13,opt,name=score,proto3" json:"score,omitempty"`
+	Relay           bool                   `protobuf:"varint,14,opt,name=relay,proto3" json:"relay,omitempty"`
+	Tags            []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	Suggestion      string                 `protobuf:"bytes,16,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	DomainLiteral   string                 `protobuf:"bytes,17,opt,name=domain_literal,json=domainLiteral,proto3" json:"domain_literal,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ParsedEmail) Reset() {
+	*x = ParsedEmail{}
+	mi := &file_disposable_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsedEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedEmail) ProtoMessage() {}
+
+func (x *ParsedEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedEmail.ProtoReflect.Descriptor instead.
+func (*ParsedEmail) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParsedEmail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetPreferred() string {
+	if x != nil {
+		return x.Preferred
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetExtra() string {
+	if x != nil {
+		return x.Extra
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetDisposable() bool {
+	if x != nil {
+		return x.Disposable
+	}
+	return false
+}
+
+func (x *ParsedEmail) GetSpoofedProvider() bool {
+	if x != nil {
+		return x.SpoofedProvider
+	}
+	return false
+}
+
+func (x *ParsedEmail) GetSuspiciousShape() bool {
+	if x != nil {
+		return x.SuspiciousShape
+	}
+	return false
+}
+
+func (x *ParsedEmail) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetDomainUnicode() string {
+	if x != nil {
+		return x.DomainUnicode
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetLocalPart() string {
+	if x != nil {
+		return x.LocalPart
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetRole() bool {
+	if x != nil {
+		return x.Role
+	}
+	return false
+}
+
+func (x *ParsedEmail) GetFreeProvider() bool {
+	if x != nil {
+		return x.FreeProvider
+	}
+	return false
+}
+
+func (x *ParsedEmail) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ParsedEmail) GetRelay() bool {
+	if x != nil {
+		return x.Relay
+	}
+	return false
+}
+
+func (x *ParsedEmail) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ParsedEmail) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+func (x *ParsedEmail) GetDomainLiteral() string {
+	if x != nil {
+		return x.DomainLiteral
+	}
+	return ""
+}
+
+type ParseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Options       *Options               `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_disposable_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParseRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ParseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// valid is false if the email address is invalid. error contains the reason.
+	Valid         bool         `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Parsed        *ParsedEmail `protobuf:"bytes,3,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_disposable_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParseResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ParseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetParsed() *ParsedEmail {
+	if x != nil {
+		return x.Parsed
+	}
+	return nil
+}
+
+type IsDisposableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsDisposableRequest) Reset() {
+	*x = IsDisposableRequest{}
+	mi := &file_disposable_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsDisposableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDisposableRequest) ProtoMessage() {}
+
+func (x *IsDisposableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDisposableRequest.ProtoReflect.Descriptor instead.
+func (*IsDisposableRequest) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IsDisposableRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type IsDisposableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disposable    bool                   `protobuf:"varint,1,opt,name=disposable,proto3" json:"disposable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsDisposableResponse) Reset() {
+	*x = IsDisposableResponse{}
+	mi := &file_disposable_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsDisposableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDisposableResponse) ProtoMessage() {}
+
+func (x *IsDisposableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDisposableResponse.ProtoReflect.Descriptor instead.
+func (*IsDisposableResponse) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IsDisposableResponse) GetDisposable() bool {
+	if x != nil {
+		return x.Disposable
+	}
+	return false
+}
+
+type BulkCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emails        []string               `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
+	Options       *Options               `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCheckRequest) Reset() {
+	*x = BulkCheckRequest{}
+	mi := &file_disposable_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCheckRequest) ProtoMessage() {}
+
+func (x *BulkCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCheckRequest.ProtoReflect.Descriptor instead.
+func (*BulkCheckRequest) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BulkCheckRequest) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *BulkCheckRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type BulkCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ParseResponse       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCheckResponse) Reset() {
+	*x = BulkCheckResponse{}
+	mi := &file_disposable_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCheckResponse) ProtoMessage() {}
+
+func (x *BulkCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_disposable_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCheckResponse.ProtoReflect.Descriptor instead.
+func (*BulkCheckResponse) Descriptor() ([]byte, []int) {
+	return file_disposable_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BulkCheckResponse) GetResults() []*ParseResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_disposable_proto protoreflect.FileDescriptor
+
+const file_disposable_proto_rawDesc = "" +
+	"\n" +
+	"\x10disposable.proto\x12\rdisposable.v1\"j\n" +
+	"\aOptions\x12%\n" +
+	"\x0ecase_sensitive\x18\x01 \x01(\bR\rcaseSensitive\x12\x16\n" +
+	"\x06strict\x18\x02 \x01(\bR\x06strict\x12 \n" +
+	"\vsuggestions\x18\x03 \x01(\bR\vsuggestions\"\x8b\x04\n" +
+	"\vParsedEmail\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1c\n" +
+	"\tpreferred\x18\x02 \x01(\tR\tpreferred\x12\x1e\n" +
+	"\n" +
+	"normalized\x18\x03 \x01(\tR\n" +
+	"normalized\x12\x14\n" +
+	"\x05extra\x18\x04 \x01(\tR\x05extra\x12\x1e\n" +
+	"\n" +
+	"disposable\x18\x05 \x01(\bR\n" +
+	"disposable\x12)\n" +
+	"\x10spoofed_provider\x18\x06 \x01(\bR\x0fspoofedProvider\x12)\n" +
+	"\x10suspicious_shape\x18\a \x01(\bR\x0fsuspiciousShape\x12\x16\n" +
+	"\x06domain\x18\b \x01(\tR\x06domain\x12%\n" +
+	"\x0edomain_unicode\x18\t \x01(\tR\rdomainUnicode\x12\x1d\n" +
+	"\n" +
+	"local_part\x18\n" +
+	" \x01(\tR\tlocalPart\x12\x12\n" +
+	"\x04role\x18\v \x01(\bR\x04role\x12#\n" +
+	"\rfree_provider\x18\f \x01(\bR\ffreeProvider\x12\x14\n" +
+	"\x05score\x18\r \x01(\x01R\x05score\x12\x14\n" +
+	"\x05relay\x18\x0e \x01(\bR\x05relay\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"suggestion\x18\x10 \x01(\tR\n" +
+	"suggestion\x12%\n" +
+	"\x0edomain_literal\x18\x11 \x01(\tR\rdomainLiteral\"V\n" +
+	"\fParseRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x120\n" +
+	"\aoptions\x18\x02 \x01(\v2\x16.disposable.v1.OptionsR\aoptions\"o\n" +
+	"\rParseResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x122\n" +
+	"\x06parsed\x18\x03 \x01(\v2\x1a.disposable.v1.ParsedEmailR\x06parsed\"-\n" +
+	"\x13IsDisposableRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"6\n" +
+	"\x14IsDisposableResponse\x12\x1e\n" +
+	"\n" +
+	"disposable\x18\x01 \x01(\bR\n" +
+	"disposable\"\\\n" +
+	"\x10BulkCheckRequest\x12\x16\n" +
+	"\x06emails\x18\x01 \x03(\tR\x06emails\x120\n" +
+	"\aoptions\x18\x02 \x01(\v2\x16.disposable.v1.OptionsR\aoptions\"K\n" +
+	"\x11BulkCheckResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.disposable.v1.ParseResponseR\aresults2\x85\x02\n" +
+	"\x16DisposableEmailService\x12B\n" +
+	"\x05Parse\x12\x1b.disposable.v1.ParseRequest\x1a\x1c.disposable.v1.ParseResponse\x12W\n" +
+	"\fIsDisposable\x12\".disposable.v1.IsDisposableRequest\x1a#.disposable.v1.IsDisposableResponse\x12N\n" +
+	"\tBulkCheck\x12\x1f.disposable.v1.BulkCheckRequest\x1a .disposable.v1.BulkCheckResponseBBZ@github.com/rocketlaunchr/anti-disposable-email/grpc/disposablepbb\x06proto3"
+
+var (
+	file_disposable_proto_rawDescOnce sync.Once
+	file_disposable_proto_rawDescData []byte
+)
+
+func file_disposable_proto_rawDescGZIP() []byte {
+	file_disposable_proto_rawDescOnce.Do(func() {
+		file_disposable_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_disposable_proto_rawDesc), len(file_disposable_proto_rawDesc)))
+	})
+	return file_disposable_proto_rawDescData
+}
+
+var file_disposable_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_disposable_proto_goTypes = []any{
+	(*Options)(nil),              // 0: disposable.v1.Options
+	(*ParsedEmail)(nil),          // 1: disposable.v1.ParsedEmail
+	(*ParseRequest)(nil),         // 2: disposable.v1.ParseRequest
+	(*ParseResponse)(nil),        // 3: disposable.v1.ParseResponse
+	(*IsDisposableRequest)(nil),  // 4: disposable.v1.IsDisposableRequest
+	(*IsDisposableResponse)(nil), // 5: disposable.v1.IsDisposableResponse
+	(*BulkCheckRequest)(nil),     // 6: disposable.v1.BulkCheckRequest
+	(*BulkCheckResponse)(nil),    // 7: disposable.v1.BulkCheckResponse
+}
+var file_disposable_proto_depIdxs = []int32{
+	0, // 0: disposable.v1.ParseRequest.options:type_name -> disposable.v1.Options
+	1, // 1: disposable.v1.ParseResponse.parsed:type_name -> disposable.v1.ParsedEmail
+	0, // 2: disposable.v1.BulkCheckRequest.options:type_name -> disposable.v1.Options
+	3, // 3: disposable.v1.BulkCheckResponse.results:type_name -> disposable.v1.ParseResponse
+	2, // 4: disposable.v1.DisposableEmailService.Parse:input_type -> disposable.v1.ParseRequest
+	4, // 5: disposable.v1.DisposableEmailService.IsDisposable:input_type -> disposable.v1.IsDisposableRequest
+	6, // 6: disposable.v1.DisposableEmailService.BulkCheck:input_type -> disposable.v1.BulkCheckRequest
+	3, // 7: disposable.v1.DisposableEmailService.Parse:output_type -> disposable.v1.ParseResponse
+	5, // 8: disposable.v1.DisposableEmailService.IsDisposable:output_type -> disposable.v1.IsDisposableResponse
+	7, // 9: disposable.v1.DisposableEmailService.BulkCheck:output_type -> disposable.v1.BulkCheckResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_disposable_proto_init() }
+func file_disposable_proto_init() {
+	if File_disposable_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_disposable_proto_rawDesc), len(file_disposable_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_disposable_proto_goTypes,
+		DependencyIndexes: file_disposable_proto_depIdxs,
+		MessageInfos:      file_disposable_proto_msgTypes,
+	}.Build()
+	File_disposable_proto = out.File
+	file_disposable_proto_goTypes = nil
+	file_disposable_proto_depIdxs = nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: disposable.proto
+
+package disposablepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DisposableEmailService_Parse_FullMethodName        = "/disposable.v1.DisposableEmailService/Parse"
+	DisposableEmailService_IsDisposable_FullMethodName = "/disposable.v1.DisposableEmailService/IsDisposable"
+	DisposableEmailService_BulkCheck_FullMethodName    = "/disposable.v1.DisposableEmailService/BulkCheck"
+)
+
+// DisposableEmailServiceClient is the client API for DisposableEmailService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DisposableEmailService parses email addresses and detects disposable email services.
+type DisposableEmailServiceClient interface {
+	// Parse parses and normalizes an email address.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// IsDisposable returns whether a domain is from a disposable email service.
+	IsDisposable(ctx context.Context, in *IsDisposableRequest, opts ...grpc.CallOption) (*IsDisposableResponse, error)
+	// BulkCheck parses multiple email addresses. The results are in the same order as the request.
+	BulkCheck(ctx context.Context, in *BulkCheckRequest, opts ...grpc.CallOption) (*BulkCheckResponse, error)
+}
+
+type disposableEmailServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDisposableEmailServiceClient(cc grpc.ClientConnInterface) DisposableEmailServiceClient {
+	return &disposableEmailServiceClient{cc}
+}
+
+func (c *disposableEmailServiceClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DisposableEmailService_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disposableEmailServiceClient) IsDisposable(ctx context.Context, in *IsDisposableRequest, opts ...grpc.CallOption) (*IsDisposableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsDisposableResponse)
+	err := c.cc.Invoke(ctx, DisposableEmailService_IsDisposable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disposableEmailServiceClient) BulkCheck(ctx context.Context, in *BulkCheckRequest, opts ...grpc.CallOption) (*BulkCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCheckResponse)
+	err := c.cc.Invoke(ctx, DisposableEmailService_BulkCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DisposableEmailServiceServer is the server API for DisposableEmailService service.
+// All implementations must embed UnimplementedDisposableEmailServiceServer
+// for forward compatibility.
+//
+// DisposableEmailService parses email addresses and detects disposable email services.
+type DisposableEmailServiceServer interface {
+	// Parse parses and normalizes an email address.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// IsDisposable returns whether a domain is from a disposable email service.
+	IsDisposable(context.Context, *IsDisposableRequest) (*IsDisposableResponse, error)
+	// BulkCheck parses multiple email addresses. The results are in the same order as the request.
+	BulkCheck(context.Context, *BulkCheckRequest) (*BulkCheckResponse, error)
+	mustEmbedUnimplementedDisposableEmailServiceServer()
+}
+
+// UnimplementedDisposableEmailServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDisposableEmailServiceServer struct{}
+
+func (UnimplementedDisposableEmailServiceServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedDisposableEmailServiceServer) IsDisposable(context.Context, *IsDisposableRequest) (*IsDisposableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IsDisposable not implemented")
+}
+func (UnimplementedDisposableEmailServiceServer) BulkCheck(context.Context, *BulkCheckRequest) (*BulkCheckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkCheck not implemented")
+}
+func (UnimplementedDisposableEmailServiceServer) mustEmbedUnimplementedDisposableEmailServiceServer() {
+}
+func (UnimplementedDisposableEmailServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDisposableEmailServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DisposableEmailServiceServer will
+// result in compilation errors.
+type UnsafeDisposableEmailServiceServer interface {
+	mustEmbedUnimplementedDisposableEmailServiceServer()
+}
+
+func RegisterDisposableEmailServiceServer(s grpc.ServiceRegistrar, srv DisposableEmailServiceServer) {
+	// If the following call panics, it indicates UnimplementedDisposableEmailServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DisposableEmailService_ServiceDesc, srv)
+}
+
+func _DisposableEmailService_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisposableEmailServiceServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisposableEmailService_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisposableEmailServiceServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisposableEmailService_IsDisposable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsDisposableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisposableEmailServiceServer).IsDisposable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisposableEmailService_IsDisposable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisposableEmailServiceServer).IsDisposable(ctx, req.(*IsDisposableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisposableEmailService_BulkCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisposableEmailServiceServer).BulkCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisposableEmailService_BulkCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisposableEmailServiceServer).BulkCheck(ctx, req.(*BulkCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DisposableEmailService_ServiceDesc is the grpc.ServiceDesc for DisposableEmailService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DisposableEmailService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "disposable.v1.DisposableEmailService",
+	HandlerType: (*DisposableEmailServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _DisposableEmailService_Parse_Handler,
+		},
+		{
+			MethodName: "IsDisposable",
+			Handler:    _DisposableEmailService_IsDisposable_Handler,
+		},
+		{
+			MethodName: "BulkCheck",
+			Handler:    _DisposableEmailService_BulkCheck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "disposable.proto",
+}
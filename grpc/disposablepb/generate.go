@@ -0,0 +1,6 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package disposablepb contains the protobuf and gRPC definitions of the DisposableEmailService.
+package disposablepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative disposable.proto
@@ -0,0 +1,20 @@
+module github.com/rocketlaunchr/anti-disposable-email/grpc
+
+go 1.25.0
+
+require (
+	github.com/rocketlaunchr/anti-disposable-email v1.1.0
+	google.golang.org/grpc v1.84.0
+	google.golang.org/protobuf v1.36.12
+)
+
+require (
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+)
+
+// The root module must be tagged (v1.1.0) before this module is tagged. The replace
+// directive only applies when developing within this repository.
+replace github.com/rocketlaunchr/anti-disposable-email => ../
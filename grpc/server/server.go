@@ -0,0 +1,117 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package server implements the DisposableEmailService gRPC service.
+//
+// Example:
+//
+//	s := grpc.NewServer()
+//	disposablepb.RegisterDisposableEmailServiceServer(s, server.New(nil))
+package server
+
+import (
+	"context"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+	pb "github.com/rocketlaunchr/anti-disposable-email/grpc/disposablepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxBulkCheck is the maximum number of email addresses accepted by BulkCheck.
+const MaxBulkCheck = 10000
+
+// Server implements pb.DisposableEmailServiceServer.
+type Server struct {
+	pb.UnimplementedDisposableEmailServiceServer
+
+	checker *disposable.Checker
+}
+
+// New creates a new Server. If c is nil, the package-level configuration
+// (disposable.ParseEmail) is used.
+func New(c *disposable.Checker) *Server {
+	return &Server{checker: c}
+}
+
+func (s *Server) parse(email string, o *pb.Options) *pb.ParseResponse {
+	var opts []disposable.Option
+	if o.GetCaseSensitive() {
+		opts = append(opts, disposable.WithCaseSensitive())
+	}
+	if o.GetStrict() {
+		opts = append(opts, disposable.WithStrictValidation())
+	}
+	if o.GetSuggestions() {
+		opts = append(opts, disposable.WithSuggestions())
+	}
+
+	var (
+		p   disposable.ParsedEmail
+		err error
+	)
+	if s.checker != nil {
+		p, err = s.checker.Parse(email, opts...)
+	} else {
+		p, err = disposable.ParseEmail(email, opts...)
+	}
+
+	if err != nil {
+		return &pb.ParseResponse{Error: err.Error()}
+	}
+
+	return &pb.ParseResponse{
+		Valid: true,
+		Parsed: &pb.ParsedEmail{
+			Email:           p.Email,
+			Preferred:       p.Preferred,
+			Normalized:      p.Normalized,
+			Extra:           p.Extra,
+			Tags:            p.Tags,
+			Disposable:      p.Disposable,
+			Score:           p.Score,
+			Relay:           p.Relay,
+			FreeProvider:    p.FreeProvider,
+			SpoofedProvider: p.SpoofedProvider,
+			SuspiciousShape: p.SuspiciousShape,
+			Role:            p.Role,
+			Suggestion:      p.Suggestion,
+			Domain:          p.Domain,
+			DomainUnicode:   p.DomainUnicode,
+			DomainLiteral:   p.DomainLiteral,
+			LocalPart:       p.LocalPart,
+		},
+	}
+}
+
+// Parse implements pb.DisposableEmailServiceServer.
+func (s *Server) Parse(ctx context.Context, req *pb.ParseRequest) (*pb.ParseResponse, error) {
+	return s.parse(req.GetEmail(), req.GetOptions()), nil
+}
+
+// IsDisposable implements pb.DisposableEmailServiceServer.
+func (s *Server) IsDisposable(ctx context.Context, req *pb.IsDisposableRequest) (*pb.IsDisposableResponse, error) {
+	var d bool
+	if s.checker != nil {
+		d = s.checker.IsDisposable(req.GetDomain())
+	} else {
+		d = disposable.IsDisposableDomain(req.GetDomain())
+	}
+	return &pb.IsDisposableResponse{Disposable: d}, nil
+}
+
+// BulkCheck implements pb.DisposableEmailServiceServer.
+func (s *Server) BulkCheck(ctx context.Context, req *pb.BulkCheckRequest) (*pb.BulkCheckResponse, error) {
+	emails := req.GetEmails()
+	if len(emails) > MaxBulkCheck {
+		return nil, status.Errorf(codes.InvalidArgument, "too many emails: %d > %d", len(emails), MaxBulkCheck)
+	}
+
+	results := make([]*pb.ParseResponse, 0, len(emails))
+	for _, email := range emails {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		results = append(results, s.parse(email, req.GetOptions()))
+	}
+	return &pb.BulkCheckResponse{Results: results}, nil
+}
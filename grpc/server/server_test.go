@@ -0,0 +1,117 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/rocketlaunchr/anti-disposable-email/grpc/disposablepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T) pb.DisposableEmailServiceClient {
+	l := bufconn.Listen(1 << 20)
+
+	s := grpc.NewServer()
+	pb.RegisterDisposableEmailServiceServer(s, New(nil))
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewDisposableEmailServiceClient(conn)
+}
+
+func TestParse(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	resp, err := c.Parse(ctx, &pb.ParseRequest{Email: "John.Smith+news@googlemail.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := resp.GetParsed()
+	if !resp.GetValid() || p.GetNormalized() != "johnsmith" || p.GetDomain() != "gmail.com" || !p.GetFreeProvider() {
+		t.Errorf("got %v", resp)
+	}
+	if tags := p.GetTags(); len(tags) != 1 || tags[0] != "news" {
+		t.Errorf("Tags = %v, want [news]", tags)
+	}
+
+	resp, err = c.Parse(ctx, &pb.ParseRequest{Email: "admin@mailinator.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := resp.GetParsed(); !p.GetDisposable() || !p.GetRole() {
+		t.Errorf("got %v, want disposable role account", resp)
+	}
+
+	resp, err = c.Parse(ctx, &pb.ParseRequest{Email: "john@gamil.com", Options: &pb.Options{Suggestions: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := resp.GetParsed().GetSuggestion(); s != "gmail.com" {
+		t.Errorf("Suggestion = %s, want gmail.com", s)
+	}
+
+	resp, err = c.Parse(ctx, &pb.ParseRequest{Email: "not an email"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetValid() || resp.GetError() == "" || resp.GetParsed() != nil {
+		t.Errorf("got %v, want invalid", resp)
+	}
+}
+
+func TestIsDisposable(t *testing.T) {
+	c := newTestClient(t)
+
+	for domain, want := range map[string]bool{"mailinator.com": true, "gmail.com": false} {
+		resp, err := c.IsDisposable(context.Background(), &pb.IsDisposableRequest{Domain: domain})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetDisposable() != want {
+			t.Errorf("%s: got %v, want %v", domain, resp.GetDisposable(), want)
+		}
+	}
+}
+
+func TestBulkCheck(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	emails := []string{"john@gmail.com", "invalid", "jane@mailinator.com"}
+	resp, err := c.BulkCheck(ctx, &pb.BulkCheckRequest{Emails: emails})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := resp.GetResults()
+	if len(results) != len(emails) {
+		t.Fatalf("got %d results, want %d", len(results), len(emails))
+	}
+	if !results[0].GetValid() || results[1].GetValid() || !results[2].GetParsed().GetDisposable() {
+		t.Errorf("got %v", results)
+	}
+
+	_, err = c.BulkCheck(ctx, &pb.BulkCheckRequest{Emails: make([]string, MaxBulkCheck+1)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"strings"
+)
+
+// SuspiciousTLDs contains top-level domains that are disproportionately used by disposable
+// email services, usually because they are free or very cheap to register. They must be
+// lower-case and in punycode. It is used to calculate ParsedEmail.Score and can be modified
+// during initialization.
+var SuspiciousTLDs = map[string]struct{}{
+	"buzz":    {},
+	"cf":      {},
+	"click":   {},
+	"club":    {},
+	"ga":      {},
+	"gq":      {},
+	"icu":     {},
+	"link":    {},
+	"ml":      {},
+	"monster": {},
+	"online":  {},
+	"rest":    {},
+	"site":    {},
+	"space":   {},
+	"tk":      {},
+	"top":     {},
+	"website": {},
+	"work":    {},
+	"xyz":     {},
+}
+
+// DisposableMXHosts contains the mail servers of disposable email services. Many disposable
+// services rotate through new domains that all point to the same mail servers. A domain
+// is matched if one of its MX hosts is equal to, or a subdomain of, an entry.
+// It is used by MXDetector and can be modified during initialization.
+var DisposableMXHosts = []string{
+	"mail.guerrillamail.com",
+	"mx.mailinator.com",
+	"mx.yopmail.com",
+	"mx1.10minutemail.com",
+	"mx.temp-mail.org",
+	"in.mail.tm",
+	"mx.dropmail.me",
+	"mx.getnada.com",
+	"mail.maildrop.cc",
+	"mx.trashmail.com",
+}
+
+// The weights (in percent) of each heuristic used to calculate ParsedEmail.Score.
+const (
+	scoreSuspiciousTLD   = 35
+	scoreRandomDomain    = 35
+	scoreSpoofedProvider = 30
+	scoreRandomLocalPart = 20
+)
+
+// score calculates ParsedEmail.Score for an email address that is not known to be disposable.
+func score(p ParsedEmail) float64 {
+	var s int
+
+	if !p.FreeProvider {
+		if idx := strings.LastIndexByte(p.Domain, '.'); idx != -1 {
+			if _, exists := SuspiciousTLDs[p.Domain[idx+1:]]; exists {
+				s += scoreSuspiciousTLD
+			}
+
+			name := p.Domain[:idx]
+			name = name[strings.LastIndexByte(name, '.')+1:]
+			if randomLooking(name) || shortAlphanumeric(name) {
+				s += scoreRandomDomain
+			}
+		}
+
+		if p.SpoofedProvider {
+			s += scoreSpoofedProvider
+		}
+	}
+
+	if randomLooking(p.Normalized) {
+		s += scoreRandomLocalPart
+	}
+
+	if s > 100 {
+		return 1
+	}
+	return float64(s) / 100
+}
+
+// randomLooking returns true if s looks like a machine-generated token rather than
+// a word or name (e.g. x7k2p9qz or hfgkwrtz).
+func randomLooking(s string) bool {
+	if len(s) < 8 {
+		return false
+	}
+
+	var (
+		letters, vowels, transitions int
+		run, maxRun                  int
+		prevDigit                    bool
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z':
+			letters++
+			if strings.IndexByte("aeiouy", c) != -1 {
+				vowels++
+				run = 0
+			} else {
+				run++
+				if run > maxRun {
+					maxRun = run
+				}
+			}
+			if i > 0 && prevDigit {
+				transitions++
+			}
+			prevDigit = false
+		case '0' <= c && c <= '9':
+			run = 0
+			if i > 0 && !prevDigit {
+				transitions++
+			}
+			prevDigit = true
+		default:
+			// Separators suggest a human-chosen name (e.g. john.smith)
+			return false
+		}
+	}
+
+	switch {
+	case transitions >= 4:
+		return true
+	case maxRun >= 6:
+		return true
+	case letters >= 8 && vowels*10 < letters:
+		return true
+	}
+	return false
+}
+
+// shortAlphanumeric returns true if s is very short and mixes letters and digits
+// (e.g. x7 or 4bq). Such names are cheap to mass-register.
+func shortAlphanumeric(s string) bool {
+	if len(s) > 4 {
+		return false
+	}
+
+	var letters, digits bool
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case 'a' <= c && c <= 'z':
+			letters = true
+		case '0' <= c && c <= '9':
+			digits = true
+		}
+	}
+	return letters && digits
+}
+
+// MXDetector is a Detector that considers a domain disposable if its mail servers
+// belong to a known disposable email service. See DisposableMXHosts.
+//
+// Example:
+//
+//	p, err := disposable.ParseEmail(email, disposable.WithDetectors(disposable.MXDetector{}))
+type MXDetector struct {
+	// Verifier is used to perform the DNS lookups. If nil, DefaultVerifier is used.
+	Verifier *Verifier
+}
+
+// IsDisposable implements the Detector interface. A domain that does not exist is
+// not considered disposable.
+func (d MXDetector) IsDisposable(ctx context.Context, domain string) (bool, error) {
+	v := d.Verifier
+	if v == nil {
+		v = DefaultVerifier
+	}
+
+	mxs, err := v.lookupMX(ctx, domain)
+	if err != nil {
+		return false, err
+	}
+
+	for _, mx := range mxs {
+		host := strings.TrimSuffix(strings.ToLower(mx.Host), ".")
+		for _, h := range DisposableMXHosts {
+			if host == h || strings.HasSuffix(host, "."+h) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
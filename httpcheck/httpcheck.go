@@ -0,0 +1,236 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package httpcheck provides a ready-made http.Handler for checking email addresses
+// and middleware that rejects requests containing disposable email addresses.
+package httpcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// DefaultMaxBodySize is the default maximum size of a JSON request body inspected by Middleware.
+const DefaultMaxBodySize = 1 << 20
+
+// errBodyTooLarge is returned by extract if the JSON request body is larger than the maximum size.
+var errBodyTooLarge = errors.New("request body too large")
+
+// Response is the JSON response returned by Handler and by Middleware when a request is rejected.
+type Response struct {
+	Email      string `json:"email"`
+	Valid      bool   `json:"valid"`
+	Disposable bool   `json:"disposable"`
+	Normalized string `json:"normalized,omitempty"`
+	Domain     string `json:"domain,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Option configures Handler and Middleware.
+type Option func(*config)
+
+type config struct {
+	checker       *disposable.Checker
+	field         string
+	maxBodySize   int64
+	rejectInvalid bool
+	reject        func(w http.ResponseWriter, r *http.Request, resp Response)
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{field: "email", maxBodySize: DefaultMaxBodySize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.reject == nil {
+		cfg.reject = func(w http.ResponseWriter, r *http.Request, resp Response) {
+			writeJSON(w, http.StatusUnprocessableEntity, resp)
+		}
+	}
+	return cfg
+}
+
+// WithChecker uses c to parse email addresses. The default is disposable.ParseEmail.
+func WithChecker(c *disposable.Checker) Option {
+	return func(cfg *config) {
+		cfg.checker = c
+	}
+}
+
+// WithField sets the query parameter (Handler) or form/JSON field (Middleware) that
+// contains the email address. The default is "email".
+func WithField(name string) Option {
+	return func(cfg *config) {
+		cfg.field = name
+	}
+}
+
+// WithMaxBodySize sets the maximum size of a JSON request body inspected by Middleware.
+// Requests with larger bodies are rejected with status 413, so the check can't be bypassed
+// by padding the body.
+func WithMaxBodySize(n int64) Option {
+	return func(cfg *config) {
+		cfg.maxBodySize = n
+	}
+}
+
+// WithRejectInvalid makes Middleware also reject invalid email addresses.
+func WithRejectInvalid() Option {
+	return func(cfg *config) {
+		cfg.rejectInvalid = true
+	}
+}
+
+// WithRejectFunc sets the function Middleware calls to respond to a rejected request.
+// The default responds with status 422 and the Response as JSON.
+func WithRejectFunc(fn func(w http.ResponseWriter, r *http.Request, resp Response)) Option {
+	return func(cfg *config) {
+		cfg.reject = fn
+	}
+}
+
+func (cfg *config) check(email string) Response {
+	var (
+		p   disposable.ParsedEmail
+		err error
+	)
+	if cfg.checker != nil {
+		p, err = cfg.checker.Parse(email)
+	} else {
+		p, err = disposable.ParseEmail(email)
+	}
+
+	if err != nil {
+		return Response{Email: email, Error: err.Error()}
+	}
+
+	return Response{
+		Email:      p.Email,
+		Valid:      true,
+		Disposable: p.Disposable,
+		Normalized: p.Normalized + "@" + p.Domain,
+		Domain:     p.Domain,
+	}
+}
+
+// Handler returns an http.Handler that checks the email address provided in the
+// query string (e.g. GET /check?email=john@gmail.com) and responds with a Response as JSON.
+func Handler(opts ...Option) http.Handler {
+	cfg := newConfig(opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		email := r.URL.Query().Get(cfg.field)
+		if email == "" {
+			writeJSON(w, http.StatusBadRequest, Response{Error: "missing " + cfg.field})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, cfg.check(email))
+	})
+}
+
+// Middleware rejects requests whose form or JSON field (see WithField) contains a
+// disposable email address. Requests without the field are passed through.
+// JSON request bodies larger than the maximum size (see WithMaxBodySize) are rejected
+// with status 413. A body is treated as JSON if its media type is JSON (including "+json"
+// types) or if it starts with '{'.
+func Middleware(next http.Handler, opts ...Option) http.Handler {
+	cfg := newConfig(opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email, ok, err := cfg.extract(r)
+		if err == errBodyTooLarge {
+			writeJSON(w, http.StatusRequestEntityTooLarge, Response{Error: err.Error()})
+			return
+		} else if err != nil {
+			writeJSON(w, http.StatusBadRequest, Response{Error: err.Error()})
+			return
+		}
+
+		if ok {
+			resp := cfg.check(email)
+			if resp.Disposable || (cfg.rejectInvalid && !resp.Valid) {
+				cfg.reject(w, r, resp)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// extract returns the email address in the request. The request body is restored
+// so it can be read again by the next handler.
+//
+// Bodies with a JSON media type (including "+json" types) are decoded as JSON. So are
+// bodies with any other media type that start with '{', since many clients send JSON
+// without the correct Content-Type (e.g. fetch sends "text/plain;charset=UTF-8").
+func (cfg *config) extract(r *http.Request) (string, bool, error) {
+
+	ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if ct == "application/x-www-form-urlencoded" || ct == "multipart/form-data" || r.Body == nil || r.Body == http.NoBody {
+		return cfg.formValue(r)
+	}
+
+	isJSON := ct == "application/json" || strings.HasSuffix(ct, "+json")
+	if isJSON && r.ContentLength > cfg.maxBodySize {
+		return "", false, errBodyTooLarge
+	}
+
+	orig := r.Body
+	body, err := ioutil.ReadAll(io.LimitReader(orig, cfg.maxBodySize+1))
+	r.Body = readCloser{io.MultiReader(bytes.NewReader(body), orig), orig}
+	if err != nil {
+		return "", false, err
+	}
+
+	if !isJSON && !bytes.HasPrefix(bytes.TrimLeft(body, " \t\r\n"), []byte("{")) {
+		return cfg.formValue(r)
+	}
+
+	if int64(len(body)) > cfg.maxBodySize {
+		return "", false, errBodyTooLarge
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return "", false, nil
+	}
+
+	var email string
+	if err := json.Unmarshal(fields[cfg.field], &email); err != nil || email == "" {
+		return "", false, nil
+	}
+	return email, true, nil
+}
+
+// formValue returns the email address in the query string or form.
+func (cfg *config) formValue(r *http.Request) (string, bool, error) {
+	if email := r.FormValue(cfg.field); email != "" {
+		return email, true, nil
+	}
+	return "", false, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,81 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package httpcheck
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	var received string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = string(b)
+		w.WriteHeader(http.StatusOK)
+	})
+	h := Middleware(next, WithMaxBodySize(1024))
+
+	padding := `"padding":"` + strings.Repeat("x", 2048) + `"`
+
+	tests := []struct {
+		name   string
+		ct     string
+		body   io.Reader
+		status int
+	}{
+		{"clean json", "application/json", strings.NewReader(`{"email":"john@gmail.com"}`), http.StatusOK},
+		{"disposable json", "application/json", strings.NewReader(`{"email":"john@mailinator.com"}`), http.StatusUnprocessableEntity},
+		{"missing field", "application/json", strings.NewReader(`{"name":"john"}`), http.StatusOK},
+		{"padded json", "application/json", strings.NewReader(`{"email":"john@mailinator.com",` + padding + `}`), http.StatusRequestEntityTooLarge},
+		{"padded chunked json", "application/json", io.MultiReader(strings.NewReader(`{"email":"john@mailinator.com",` + padding + `}`)), http.StatusRequestEntityTooLarge},
+		{"clean form", "application/x-www-form-urlencoded", strings.NewReader(url.Values{"email": {"john@gmail.com"}}.Encode()), http.StatusOK},
+		{"disposable form", "application/x-www-form-urlencoded", strings.NewReader(url.Values{"email": {"john@mailinator.com"}}.Encode()), http.StatusUnprocessableEntity},
+		{"no content type", "", strings.NewReader(`{"email":"john@mailinator.com"}`), http.StatusUnprocessableEntity},
+		{"text/plain json", "text/plain;charset=UTF-8", strings.NewReader(`{"email":"john@mailinator.com"}`), http.StatusUnprocessableEntity},
+		{"text/plain json with white-space", "text/plain", strings.NewReader("\n {\"email\":\"john@mailinator.com\"}"), http.StatusUnprocessableEntity},
+		{"+json", "application/vnd.api+json", strings.NewReader(`{"email":"john@mailinator.com"}`), http.StatusUnprocessableEntity},
+		{"clean +json", "application/vnd.api+json", strings.NewReader(`{"email":"john@gmail.com"}`), http.StatusOK},
+		{"padded text/plain json", "text/plain", strings.NewReader(`{"email":"john@mailinator.com",` + padding + `}`), http.StatusRequestEntityTooLarge},
+		{"text/plain", "text/plain", strings.NewReader("john@mailinator.com"), http.StatusOK},
+		{"large binary", "application/octet-stream", strings.NewReader(strings.Repeat("x", 4096)), http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		received = ""
+
+		r := httptest.NewRequest(http.MethodPost, "/signup", tt.body)
+		r.Header.Set("Content-Type", tt.ct)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		if w.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, w.Code, tt.status)
+		}
+		if tt.status == http.StatusOK && !strings.HasPrefix(tt.ct, "application/x-www-form-urlencoded") && received == "" {
+			t.Errorf("%s: request body was not restored", tt.name)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h := Handler()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/check?email=john@mailinator.com", nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"disposable":true`) {
+		t.Errorf("got %d %s, want 200 with disposable", w.Code, w.Body)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/check", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("got %d, want 400", w.Code)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package kv provides a disposable.Detector backed by an external key-value store
+// (such as Redis), with a local LRU cache.
+//
+// This allows a fleet of stateless services to share a single, frequently-updated
+// blocklist instead of each one downloading the list on startup.
+//
+// Example with github.com/redis/go-redis:
+//
+//	store := kv.StoreFunc(func(ctx context.Context, domain string) (bool, error) {
+//		return rdb.SIsMember(ctx, "disposable-domains", domain).Result()
+//	})
+//
+//	d := kv.NewDetector(store, kv.WithCacheSize(10000), kv.WithTTL(time.Hour))
+//	p, err := disposable.ParseEmail(email, disposable.WithDetectors(d))
+package kv
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Store checks if a domain is present in the external key-value store.
+type Store interface {
+	Contains(ctx context.Context, domain string) (bool, error)
+}
+
+// StoreFunc is an adapter to allow the use of an ordinary function as a Store.
+type StoreFunc func(ctx context.Context, domain string) (bool, error)
+
+// Contains implements the Store interface.
+func (f StoreFunc) Contains(ctx context.Context, domain string) (bool, error) {
+	return f(ctx, domain)
+}
+
+// Option configures a Detector.
+type Option func(*Detector)
+
+// WithCacheSize sets the maximum number of domains cached locally. The default is 10000.
+// If zero, results are not cached.
+func WithCacheSize(n int) Option {
+	return func(d *Detector) {
+		d.size = n
+	}
+}
+
+// WithTTL sets how long results are cached for. The default is 10 minutes.
+func WithTTL(ttl time.Duration) Option {
+	return func(d *Detector) {
+		d.ttl = ttl
+	}
+}
+
+// Detector implements disposable.Detector. Errors from the Store are not cached.
+//
+// A Detector is safe for concurrent use.
+type Detector struct {
+	store Store
+	size  int
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	ll    *list.List // most recently used at the front
+	items map[string]*list.Element
+}
+
+type entry struct {
+	domain     string
+	disposable bool
+	expires    time.Time
+}
+
+// NewDetector creates a Detector that consults store.
+func NewDetector(store Store, opts ...Option) *Detector {
+	d := &Detector{
+		store: store,
+		size:  10000,
+		ttl:   10 * time.Minute,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// IsDisposable implements the disposable.Detector interface.
+func (d *Detector) IsDisposable(ctx context.Context, domain string) (bool, error) {
+	if disposable, ok := d.get(domain); ok {
+		return disposable, nil
+	}
+
+	disposable, err := d.store.Contains(ctx, domain)
+	if err != nil {
+		return false, err
+	}
+
+	d.add(domain, disposable)
+	return disposable, nil
+}
+
+// Purge removes all cached results. It should be called after the store is updated.
+func (d *Detector) Purge() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.ll.Init()
+	d.items = map[string]*list.Element{}
+}
+
+func (d *Detector) get(domain string) (bool, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	elem, exists := d.items[domain]
+	if !exists {
+		return false, false
+	}
+
+	e := elem.Value.(*entry)
+	if time.Now().After(e.expires) {
+		d.ll.Remove(elem)
+		delete(d.items, domain)
+		return false, false
+	}
+
+	d.ll.MoveToFront(elem)
+	return e.disposable, true
+}
+
+func (d *Detector) add(domain string, disposable bool) {
+	if d.size <= 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e := &entry{domain: domain, disposable: disposable, expires: time.Now().Add(d.ttl)}
+
+	if elem, exists := d.items[domain]; exists {
+		elem.Value = e
+		d.ll.MoveToFront(elem)
+		return
+	}
+
+	d.items[domain] = d.ll.PushFront(e)
+
+	for d.ll.Len() > d.size {
+		oldest := d.ll.Back()
+		d.ll.Remove(oldest)
+		delete(d.items, oldest.Value.(*entry).domain)
+	}
+}
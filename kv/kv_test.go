@@ -0,0 +1,129 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// countingStore is a Store that counts lookups. Domains in fail return an error.
+type countingStore struct {
+	disposable map[string]bool
+	fail       map[string]bool
+	calls      map[string]int
+}
+
+func newCountingStore() *countingStore {
+	return &countingStore{
+		disposable: map[string]bool{"mailinator.com": true},
+		fail:       map[string]bool{},
+		calls:      map[string]int{},
+	}
+}
+
+func (s *countingStore) Contains(ctx context.Context, domain string) (bool, error) {
+	s.calls[domain]++
+	if s.fail[domain] {
+		return false, errors.New("store unavailable")
+	}
+	return s.disposable[domain], nil
+}
+
+func TestDetectorLRU(t *testing.T) {
+	store := newCountingStore()
+	d := NewDetector(store, WithCacheSize(2))
+	ctx := context.Background()
+
+	for _, domain := range []string{"a.com", "b.com", "a.com", "c.com", "a.com", "b.com"} {
+		if _, err := d.IsDisposable(ctx, domain); err != nil {
+			t.Fatalf("%s: unexpected error: %v", domain, err)
+		}
+	}
+
+	// b.com was evicted when c.com was cached, since a.com was used more recently
+	for domain, want := range map[string]int{"a.com": 1, "b.com": 2, "c.com": 1} {
+		if n := store.calls[domain]; n != want {
+			t.Errorf("%s: %d lookups, want %d", domain, n, want)
+		}
+	}
+	if n := d.ll.Len(); n != 2 {
+		t.Errorf("%d cached domains, want 2", n)
+	}
+}
+
+func TestDetectorTTL(t *testing.T) {
+	store := newCountingStore()
+	d := NewDetector(store, WithTTL(time.Millisecond))
+	ctx := context.Background()
+
+	disposable, err := d.IsDisposable(ctx, "mailinator.com")
+	if err != nil || !disposable {
+		t.Fatalf("got %v, %v, want true, nil", disposable, err)
+	}
+	d.IsDisposable(ctx, "mailinator.com")
+	if n := store.calls["mailinator.com"]; n != 1 {
+		t.Errorf("%d lookups before expiry, want 1", n)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	d.IsDisposable(ctx, "mailinator.com")
+	if n := store.calls["mailinator.com"]; n != 2 {
+		t.Errorf("%d lookups after expiry, want 2", n)
+	}
+}
+
+func TestDetectorPurge(t *testing.T) {
+	store := newCountingStore()
+	d := NewDetector(store)
+	ctx := context.Background()
+
+	d.IsDisposable(ctx, "a.com")
+	d.Purge()
+	if n := d.ll.Len(); n != 0 {
+		t.Errorf("%d cached domains after Purge, want 0", n)
+	}
+
+	// The store was updated
+	store.disposable["a.com"] = true
+	if disposable, _ := d.IsDisposable(ctx, "a.com"); !disposable {
+		t.Error("got cached result after Purge")
+	}
+	if n := store.calls["a.com"]; n != 2 {
+		t.Errorf("%d lookups, want 2", n)
+	}
+}
+
+func TestDetectorErrorsNotCached(t *testing.T) {
+	store := newCountingStore()
+	store.fail["a.com"] = true
+	d := NewDetector(store)
+	ctx := context.Background()
+
+	if _, err := d.IsDisposable(ctx, "a.com"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	store.fail["a.com"] = false
+	if _, err := d.IsDisposable(ctx, "a.com"); err != nil {
+		t.Fatalf("error was cached: %v", err)
+	}
+	if n := store.calls["a.com"]; n != 2 {
+		t.Errorf("%d lookups, want 2", n)
+	}
+}
+
+func TestDetectorNoCache(t *testing.T) {
+	store := newCountingStore()
+	d := NewDetector(store, WithCacheSize(0))
+	ctx := context.Background()
+
+	d.IsDisposable(ctx, "a.com")
+	d.IsDisposable(ctx, "a.com")
+	if n := store.calls["a.com"]; n != 2 {
+		t.Errorf("%d lookups, want 2", n)
+	}
+}
@@ -2,10 +2,11 @@
 
 package disposable
 
-// DisposableList is the list of domains that are considered to be
+// DisposableList is the bundled list of domains that are considered to be
 // from disposable email service providers. See: https://github.com/martenson/disposable-email-domains.
+// It is used to initialize ActiveList.
 //
-// NOTE: To update the list, refer to the 'update' sub-package.
+// NOTE: To update ActiveList, refer to the 'update' sub-package.
 var DisposableList = map[string]struct{}{
 	"0-mail.com":                             {},
 	"027168.com":                             {},
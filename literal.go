@@ -0,0 +1,72 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"net"
+	"strings"
+)
+
+// WithDomainLiterals permits address literals as the domain (e.g. user@[192.168.1.1] or
+// user@[IPv6:::1]). ParsedEmail.DomainLiteral is set to the IP address, so a separate policy
+// can be applied to them. By default, a *ValidationError wrapping ErrDomainLiteral is returned.
+//
+// NOTE: Domain literals are not checked against the lists.
+func WithDomainLiterals() Option {
+	return func(cfg *config) {
+		cfg.literals = true
+	}
+}
+
+// WithPortStripping removes an accidental trailing port from the domain (e.g. user@example.com:25).
+// By default, a *ValidationError wrapping ErrDomainPort is returned.
+func WithPortStripping() Option {
+	return func(cfg *config) {
+		cfg.stripPort = true
+	}
+}
+
+// splitPort splits a trailing port from domain (e.g. example.com:25 or [IPv6:::1]:25).
+// port is empty if there is none.
+func splitPort(domain string) (host, port string) {
+	idx := strings.LastIndexByte(domain, ':')
+	if idx <= 0 || idx == len(domain)-1 || len(domain)-idx-1 > 5 {
+		return domain, ""
+	}
+
+	for i := idx + 1; i < len(domain); i++ {
+		if domain[i] < '0' || domain[i] > '9' {
+			return domain, ""
+		}
+	}
+
+	// The colons within a domain literal are not a port separator
+	if domain[0] == '[' && domain[idx-1] != ']' {
+		return domain, ""
+	}
+
+	return domain[:idx], domain[idx+1:]
+}
+
+// parseLiteral parses a domain literal (e.g. [192.168.1.1] or [IPv6:::1]). It returns
+// the canonical form of the domain literal and its IP address.
+func parseLiteral(domain string) (literal string, ip string, ok bool) {
+	if len(domain) < 2 || domain[0] != '[' || domain[len(domain)-1] != ']' {
+		return "", "", false
+	}
+	inner := domain[1 : len(domain)-1]
+
+	if len(inner) > 5 && strings.EqualFold(inner[:5], "IPv6:") {
+		addr := net.ParseIP(inner[5:])
+		if addr == nil || !strings.Contains(inner[5:], ":") {
+			return "", "", false
+		}
+		return "[IPv6:" + addr.String() + "]", addr.String(), true
+	}
+
+	addr := net.ParseIP(inner)
+	if addr == nil || addr.To4() == nil || strings.Contains(inner, ":") {
+		return "", "", false
+	}
+	return "[" + addr.String() + "]", addr.String(), true
+}
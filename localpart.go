@@ -0,0 +1,99 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"regexp"
+	"strings"
+)
+
+// LocalPartRule classifies the local-parts of a domain that match Pattern. It is used for
+// services that hand out throwaway or forwarding addresses on domains that can't be
+// blocked outright.
+type LocalPartRule struct {
+	// Pattern is matched against the local-part. If nil, all local-parts match.
+	Pattern *regexp.Regexp
+
+	// Disposable marks matching email addresses as disposable.
+	Disposable bool
+
+	// Relay marks matching email addresses as relay (forwarding) addresses.
+	Relay bool
+}
+
+// LocalPartRules maps a domain to the rules for its local-parts. The domain must be
+// lower-case and in punycode. Subdomains are also matched (e.g. john.anonaddy.com).
+// It is used by ParseEmail and can be modified during initialization. Use WithLocalPartRules
+// if you require a different configuration.
+var LocalPartRules = map[string][]LocalPartRule{
+	// Apple Hide My Email
+	"privaterelay.appleid.com": {{Relay: true}},
+
+	// DuckDuckGo Email Protection
+	"duck.com": {{Relay: true}},
+
+	// Firefox Relay
+	"mozmail.com": {{Relay: true}},
+
+	// SimpleLogin
+	"simplelogin.com": {{Relay: true}},
+	"aleeas.com":      {{Relay: true}},
+	"slmail.me":       {{Relay: true}},
+
+	// addy.io
+	"anonaddy.com": {{Relay: true}},
+	"anonaddy.me":  {{Relay: true}},
+}
+
+// WithLocalPartRules sets the local-part rules. The default is LocalPartRules.
+func WithLocalPartRules(rules map[string][]LocalPartRule) Option {
+	return func(cfg *config) {
+		cfg.localPartRules = rules
+	}
+}
+
+// matchLocalPart applies the rules of domain (or its closest parent with rules)
+// to localPart.
+func (cfg *config) matchLocalPart(localPart, domain string) (disposable, relay bool) {
+	for _, rule := range cfg.rulesFor(domain) {
+		if rule.Pattern == nil || rule.Pattern.MatchString(localPart) {
+			disposable = disposable || rule.Disposable
+			relay = relay || rule.Relay
+		}
+	}
+	return
+}
+
+// disposableByRule returns true if a rule of domain (or its closest parent with rules)
+// marks every local-part as disposable.
+func (cfg *config) disposableByRule(domain string) bool {
+	for _, rule := range cfg.rulesFor(domain) {
+		if rule.Pattern == nil && rule.Disposable {
+			return true
+		}
+	}
+	return false
+}
+
+// rulesFor returns the rules of domain or its closest parent with rules.
+func (cfg *config) rulesFor(domain string) []LocalPartRule {
+	if len(cfg.localPartRules) == 0 {
+		return nil
+	}
+
+	for {
+		if rules, exists := cfg.localPartRules[domain]; exists {
+			return rules
+		}
+
+		// Move to parent domain
+		idx := strings.IndexByte(domain, '.')
+		if idx == -1 {
+			return nil
+		}
+		domain = domain[idx+1:]
+		if strings.IndexByte(domain, '.') == -1 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "sort"
+
+// Lookup is a set of domains.
+//
+// *List is backed by a map and can be updated while in use. *PackedList is immutable,
+// but uses considerably less memory.
+type Lookup interface {
+	// Contains returns true if domain is in the set.
+	Contains(domain string) bool
+
+	// Len returns the number of domains in the set.
+	Len() int
+}
+
+// PackedList is a memory-efficient Lookup. The domains are sorted and packed into
+// a single string, with 4 bytes of overhead per domain. Lookups use a binary search,
+// so they are slower than a *List but do not allocate.
+//
+// It is suitable for memory-constrained environments. For DisposableList, it retains about
+// 40% of the memory of a *List (see BenchmarkMemory).
+type PackedList struct {
+	data    string
+	offsets []uint32 // offsets[i] is the start of the i-th domain; the final entry is len(data)
+}
+
+// NewPackedList creates a PackedList containing domains.
+func NewPackedList(domains map[string]struct{}) *PackedList {
+
+	sorted := make([]string, 0, len(domains))
+	size := 0
+	for domain := range domains {
+		sorted = append(sorted, domain)
+		size += len(domain)
+	}
+	sort.Strings(sorted)
+
+	buf := make([]byte, 0, size)
+	offsets := make([]uint32, 0, len(sorted)+1)
+	for _, domain := range sorted {
+		offsets = append(offsets, uint32(len(buf)))
+		buf = append(buf, domain...)
+	}
+	offsets = append(offsets, uint32(len(buf)))
+
+	return &PackedList{data: string(buf), offsets: offsets}
+}
+
+func (p *PackedList) at(i int) string {
+	return p.data[p.offsets[i]:p.offsets[i+1]]
+}
+
+// Contains implements the Lookup interface.
+func (p *PackedList) Contains(domain string) bool {
+	n := p.Len()
+	i := sort.Search(n, func(i int) bool { return p.at(i) >= domain })
+	return i < n && p.at(i) == domain
+}
+
+// Len implements the Lookup interface.
+func (p *PackedList) Len() int {
+	return len(p.offsets) - 1
+}
@@ -0,0 +1,90 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestPackedList(t *testing.T) {
+	p := NewPackedList(DisposableList)
+
+	if p.Len() != len(DisposableList) {
+		t.Fatalf("Len() = %d, want %d", p.Len(), len(DisposableList))
+	}
+	for domain := range DisposableList {
+		if !p.Contains(domain) {
+			t.Errorf("Contains(%q) = false, want true", domain)
+		}
+	}
+	for _, domain := range []string{"", "gmail.com", "a", "zzzzzzzz.zz", "mailinator.co"} {
+		if p.Contains(domain) {
+			t.Errorf("Contains(%q) = true, want false", domain)
+		}
+	}
+
+	if empty := NewPackedList(nil); empty.Len() != 0 || empty.Contains("gmail.com") {
+		t.Error("empty PackedList must not contain any domains")
+	}
+}
+
+var benchmarkDomains = []string{"mailinator.com", "gmail.com", "yopmail.com", "example.co.uk"}
+
+func benchmarkLookup(b *testing.B, l Lookup) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l.Contains(benchmarkDomains[i%len(benchmarkDomains)])
+	}
+}
+
+func BenchmarkListContains(b *testing.B) {
+	benchmarkLookup(b, NewList(DisposableList))
+}
+
+func BenchmarkPackedListContains(b *testing.B) {
+	benchmarkLookup(b, NewPackedList(DisposableList))
+}
+
+// retained returns the heap memory retained by the value returned by fn.
+func retained(fn func() interface{}) uint64 {
+	var before, after runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	v := fn()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(v)
+
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+// BenchmarkMemory reports the memory retained by each representation of DisposableList
+// as bytes/list. The domains are copied, as they would be when loaded by the 'update' sub-package.
+func BenchmarkMemory(b *testing.B) {
+	b.Run("List", func(b *testing.B) {
+		var size uint64
+		for i := 0; i < b.N; i++ {
+			size = retained(func() interface{} {
+				m := make(map[string]struct{}, len(DisposableList))
+				for domain := range DisposableList {
+					m[strings.Clone(domain)] = struct{}{}
+				}
+				return NewList(m)
+			})
+		}
+		b.ReportMetric(float64(size), "bytes/list")
+	})
+
+	b.Run("PackedList", func(b *testing.B) {
+		var size uint64
+		for i := 0; i < b.N; i++ {
+			size = retained(func() interface{} {
+				return NewPackedList(DisposableList)
+			})
+		}
+		b.ReportMetric(float64(size), "bytes/list")
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "encoding/json"
+
+// parsedEmailJSON is the JSON representation of ParsedEmail. The field names are
+// part of the public API and must not change.
+//
+// NOTE: Any timestamps added in the future must be encoded in RFC 3339 format.
+type parsedEmailJSON struct {
+	Email           string   `json:"email"`
+	Preferred       string   `json:"preferred"`
+	Normalized      string   `json:"normalized"`
+	Extra           string   `json:"extra,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Disposable      bool     `json:"disposable"`
+	Score           float64  `json:"score"`
+	Relay           bool     `json:"relay"`
+	FreeProvider    bool     `json:"free_provider"`
+	SpoofedProvider bool     `json:"spoofed_provider"`
+	SuspiciousShape bool     `json:"suspicious_shape"`
+	Role            bool     `json:"role"`
+	Suggestion      string   `json:"suggestion,omitempty"`
+	Domain          string   `json:"domain"`
+	DomainUnicode   string   `json:"domain_unicode"`
+	DomainLiteral   string   `json:"domain_literal,omitempty"`
+	LocalPart       string   `json:"local_part"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. The field names are in snake_case
+// (e.g. local_part, free_provider). Extra and Suggestion are omitted if empty.
+func (p ParsedEmail) MarshalJSON() ([]byte, error) {
+	return json.Marshal(parsedEmailJSON{
+		Email:           p.Email,
+		Preferred:       p.Preferred,
+		Normalized:      p.Normalized,
+		Extra:           p.Extra,
+		Tags:            p.Tags,
+		Disposable:      p.Disposable,
+		Score:           p.Score,
+		Relay:           p.Relay,
+		FreeProvider:    p.FreeProvider,
+		SpoofedProvider: p.SpoofedProvider,
+		SuspiciousShape: p.SuspiciousShape,
+		Role:            p.Role,
+		Suggestion:      p.Suggestion,
+		Domain:          p.Domain,
+		DomainUnicode:   p.DomainUnicode,
+		DomainLiteral:   p.DomainLiteral,
+		LocalPart:       p.LocalPart,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The fields are restored as-is
+// and the email address is not parsed again.
+func (p *ParsedEmail) UnmarshalJSON(data []byte) error {
+	var j parsedEmailJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	*p = ParsedEmail{
+		Email:           j.Email,
+		Preferred:       j.Preferred,
+		Normalized:      j.Normalized,
+		Extra:           j.Extra,
+		Tags:            j.Tags,
+		Disposable:      j.Disposable,
+		Score:           j.Score,
+		Relay:           j.Relay,
+		FreeProvider:    j.FreeProvider,
+		SpoofedProvider: j.SpoofedProvider,
+		SuspiciousShape: j.SuspiciousShape,
+		Role:            j.Role,
+		Suggestion:      j.Suggestion,
+		Domain:          j.Domain,
+		DomainUnicode:   j.DomainUnicode,
+		DomainLiteral:   j.DomainLiteral,
+		LocalPart:       j.LocalPart,
+	}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. It returns Email.
+func (p ParsedEmail) MarshalText() ([]byte, error) {
+	return []byte(p.Email), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It parses
+// the email address using ParseEmail.
+func (p *ParsedEmail) UnmarshalText(text []byte) error {
+	parsed, err := ParseEmail(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "time"
+
+// Metrics receives instrumentation events. It can be used to export counters for
+// dashboards without wrapping every call site. Implementations must be safe for
+// concurrent use.
+//
+// See the 'prometheus' sub-module for a ready-made Prometheus implementation.
+type Metrics interface {
+	// Parsed is called after an email address is parsed. err is the error returned
+	// by the parse (e.g. a *ValidationError).
+	Parsed(p ParsedEmail, err error)
+
+	// Updated is called after an attempt to update a list. size is the number of domains
+	// in the list after the attempt and d is the duration of the attempt.
+	Updated(size int, d time.Duration, err error)
+}
+
+// DefaultMetrics receives events from ParseEmail, new Checkers and the Runner in the
+// 'update' sub-package. It is nil by default and must be set during initialization.
+var DefaultMetrics Metrics
+
+// WithMetrics sets the Metrics that receives parse events. The default is DefaultMetrics.
+func WithMetrics(m Metrics) Option {
+	return func(cfg *config) {
+		cfg.metrics = m
+	}
+}
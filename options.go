@@ -0,0 +1,157 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "strings"
+
+// Option configures ParseEmail or a Checker.
+type Option func(*config)
+
+type config struct {
+	list           Lookup
+	allow          Lookup
+	deny           Lookup
+	caseSensitive  bool
+	exactMatch     bool
+	psl            bool
+	strict         bool
+	noNormalize    bool
+	workers        int
+	roles          map[string]struct{}
+	free           Lookup
+	suggest        bool
+	localPartRules map[string][]LocalPartRule
+	detectors      []Detector
+	metrics        Metrics
+	verifyMX       bool
+	verifier       *Verifier
+	policy         Policy
+	aliases        map[string]string
+	separators     map[string]string
+	keepTags       bool
+	literals       bool
+	stripPort      bool
+}
+
+func newConfig(opts ...Option) config {
+	cfg := config{
+		list:           ActiveList,
+		roles:          RoleAccounts,
+		free:           ActiveFreeProviderList,
+		localPartRules: LocalPartRules,
+		aliases:        DomainAliases,
+		separators:     SubaddressSeparators,
+		metrics:        DefaultMetrics,
+	}
+	cfg.apply(opts)
+	return cfg
+}
+
+func (cfg *config) apply(opts []Option) {
+	for _, opt := range opts {
+		opt(cfg)
+	}
+}
+
+// isDisposable returns true if domain is found to be disposable in the lists.
+func (cfg *config) isDisposable(domain string) bool {
+	disposable, _ := cfg.lookup(domain)
+	return disposable
+}
+
+// lookup checks if domain is disposable. The allow list is consulted first,
+// followed by the deny list and then the disposable list. listed is true if
+// the domain was found in any of the lists.
+//
+// Unless exactMatch is set, the parent domains are also checked (excluding the TLD),
+// starting from the most specific. If psl is set, the registrable domain is the last
+// parent checked.
+func (cfg *config) lookup(domain string) (disposable bool, listed bool) {
+	var registrable string
+	if cfg.psl && !cfg.exactMatch {
+		registrable, _ = RegistrableDomain(domain)
+	}
+
+	for {
+		if cfg.allow != nil && cfg.allow.Contains(domain) {
+			return false, true
+		}
+		if cfg.deny != nil && cfg.deny.Contains(domain) {
+			return true, true
+		}
+		if cfg.list.Contains(domain) {
+			return true, true
+		}
+
+		if cfg.exactMatch || domain == registrable {
+			return false, false
+		}
+
+		// Move to parent domain
+		idx := strings.IndexByte(domain, '.')
+		if idx == -1 {
+			return false, false
+		}
+		domain = domain[idx+1:]
+		if strings.IndexByte(domain, '.') == -1 {
+			return false, false
+		}
+	}
+}
+
+// WithList sets the list of disposable domains. The default is ActiveList.
+func WithList(list Lookup) Option {
+	return func(cfg *config) {
+		cfg.list = list
+	}
+}
+
+// WithAllowlist sets domains that are never considered disposable, even if
+// they are found in the disposable list.
+func WithAllowlist(domains ...string) Option {
+	return func(cfg *config) {
+		cfg.allow = NewList(toSet(domains))
+	}
+}
+
+// WithDenylist sets domains that are always considered disposable, even if
+// they are not found in the disposable list.
+func WithDenylist(domains ...string) Option {
+	return func(cfg *config) {
+		cfg.deny = NewList(toSet(domains))
+	}
+}
+
+// WithoutSubdomainMatching only flags a domain if it is found exactly in the lists.
+// By default, subdomains of listed domains (e.g. mail.tempmail.io) are also matched.
+func WithoutSubdomainMatching() Option {
+	return func(cfg *config) {
+		cfg.exactMatch = true
+	}
+}
+
+// WithCaseSensitive treats the local-part as case-sensitive.
+// It is ignored for major mailbox providers which are known to be case-insensitive.
+func WithCaseSensitive() Option {
+	return func(cfg *config) {
+		cfg.caseSensitive = true
+	}
+}
+
+// WithoutNormalization disables normalization of the local-part. Normalized and
+// Preferred will be identical to LocalPart and Extra will be empty.
+func WithoutNormalization() Option {
+	return func(cfg *config) {
+		cfg.noNormalize = true
+	}
+}
+
+func toSet(domains []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		if d, err := asciiDomain(domain); err == nil {
+			set[d] = struct{}{}
+		}
+	}
+	return set
+}
@@ -0,0 +1,27 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+// Allowlist contains domains that ParseEmail and DomainVerdict never consider disposable,
+// even if they are found in ActiveList. It is useful for fixing false positives.
+//
+// It can be updated at any time using Add, Remove or Store. Use a Checker with WithAllowlist
+// if you require a different configuration.
+var Allowlist = NewList(nil)
+
+// Denylist contains domains that ParseEmail and DomainVerdict always consider disposable,
+// even if they are not found in ActiveList. It is useful for blocking new disposable
+// email services before they are added to the list.
+//
+// It can be updated at any time using Add, Remove or Store. Use a Checker with WithDenylist
+// if you require a different configuration.
+var Denylist = NewList(nil)
+
+// defaultConfig returns the configuration used by the package-level functions.
+func defaultConfig(opts ...Option) config {
+	cfg := newConfig()
+	cfg.allow = Allowlist
+	cfg.deny = Denylist
+	cfg.apply(opts)
+	return cfg
+}
@@ -0,0 +1,51 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestOverrides(t *testing.T) {
+	t.Cleanup(func() {
+		Allowlist.Store(nil)
+		Denylist.Store(nil)
+	})
+
+	Allowlist.Add("Mailinator.com")
+	Denylist.Add("new-throwaway.com")
+
+	if p, _ := ParseEmail("john@mailinator.com"); p.Disposable {
+		t.Error("allowlisted domain must not be disposable")
+	}
+	if p, _ := ParseEmail("john@new-throwaway.com"); !p.Disposable {
+		t.Error("denylisted domain must be disposable")
+	}
+
+	Allowlist.Remove("mailinator.com")
+	if p, _ := ParseEmail("john@mailinator.com"); !p.Disposable {
+		t.Error("domain removed from the allowlist must be disposable")
+	}
+}
+
+func TestListAddConcurrent(t *testing.T) {
+	l := NewList(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			domain := fmt.Sprintf("domain%d.com", i)
+			l.Add(domain)
+			l.Contains(domain)
+		}(i)
+	}
+	wg.Wait()
+
+	if l.Len() != 50 {
+		t.Errorf("Len() = %d, want 50", l.Len())
+	}
+}
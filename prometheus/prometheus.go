@@ -0,0 +1,128 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package prometheus provides a disposable.Metrics implementation that exports
+// Prometheus metrics.
+//
+// Example:
+//
+//	m := prometheus.New("myapp")
+//	prom.MustRegister(m)
+//	disposable.DefaultMetrics = m
+package prometheus
+
+import (
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// Metrics implements disposable.Metrics and prometheus.Collector.
+//
+// The following metrics are exported (prefixed by the namespace):
+//
+//   - disposable_parses_total: email addresses parsed.
+//   - disposable_invalid_total: invalid email addresses, by component (email, local-part or domain).
+//   - disposable_hits_total: disposable email addresses.
+//   - disposable_list_domains: domains in the list after the last update.
+//   - disposable_update_duration_seconds: duration of list updates.
+//   - disposable_update_failures_total: failed list updates.
+type Metrics struct {
+	parses         prom.Counter
+	invalid        *prom.CounterVec
+	hits           prom.Counter
+	listDomains    prom.Gauge
+	updateDuration prom.Histogram
+	updateFailures prom.Counter
+}
+
+var _ disposable.Metrics = (*Metrics)(nil)
+
+// New creates a Metrics. namespace is prepended to the metric names and may be empty.
+// The Metrics must be registered with a prometheus.Registerer.
+func New(namespace string) *Metrics {
+	const subsystem = "disposable"
+
+	return &Metrics{
+		parses: prom.NewCounter(prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "parses_total",
+			Help:      "Number of email addresses parsed.",
+		}),
+		invalid: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "invalid_total",
+			Help:      "Number of invalid email addresses.",
+		}, []string{"component"}),
+		hits: prom.NewCounter(prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "hits_total",
+			Help:      "Number of disposable email addresses.",
+		}),
+		listDomains: prom.NewGauge(prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "list_domains",
+			Help:      "Number of domains in the list after the last update.",
+		}),
+		updateDuration: prom.NewHistogram(prom.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "update_duration_seconds",
+			Help:      "Duration of list updates.",
+			Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}),
+		updateFailures: prom.NewCounter(prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "update_failures_total",
+			Help:      "Number of failed list updates.",
+		}),
+	}
+}
+
+// Parsed implements the disposable.Metrics interface.
+func (m *Metrics) Parsed(p disposable.ParsedEmail, err error) {
+	m.parses.Inc()
+
+	if vErr, ok := err.(*disposable.ValidationError); ok {
+		m.invalid.WithLabelValues(string(vErr.Component)).Inc()
+		return
+	}
+
+	if p.Disposable {
+		m.hits.Inc()
+	}
+}
+
+// Updated implements the disposable.Metrics interface.
+func (m *Metrics) Updated(size int, d time.Duration, err error) {
+	m.updateDuration.Observe(d.Seconds())
+	m.listDomains.Set(float64(size))
+	if err != nil {
+		m.updateFailures.Inc()
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (m *Metrics) Describe(ch chan<- *prom.Desc) {
+	m.parses.Describe(ch)
+	m.invalid.Describe(ch)
+	m.hits.Describe(ch)
+	m.listDomains.Describe(ch)
+	m.updateDuration.Describe(ch)
+	m.updateFailures.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (m *Metrics) Collect(ch chan<- prom.Metric) {
+	m.parses.Collect(ch)
+	m.invalid.Collect(ch)
+	m.hits.Collect(ch)
+	m.listDomains.Collect(ch)
+	m.updateDuration.Collect(ch)
+	m.updateFailures.Collect(ch)
+}
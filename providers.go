@@ -0,0 +1,90 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+// providerRule describes how a mailbox provider interprets the local-part.
+// All providers in the table treat the local-part as case-insensitive.
+type providerRule struct {
+	// tagSeparators contains the characters that start a sub-address tag.
+	// Everything after the first separator is ignored by the provider.
+	tagSeparators string
+
+	// ignored contains the characters that the provider removes from the local-part.
+	ignored string
+}
+
+var (
+	googleRule    = providerRule{tagSeparators: "+", ignored: "."}
+	microsoftRule = providerRule{tagSeparators: "+"}
+	yahooRule     = providerRule{tagSeparators: "-"}
+	fastmailRule  = providerRule{tagSeparators: "+"}
+	appleRule     = providerRule{tagSeparators: "+"}
+	protonRule    = providerRule{tagSeparators: "+", ignored: ".-_"}
+	zohoRule      = providerRule{tagSeparators: "+"}
+)
+
+// providerRules maps a domain to the normalization rules of its mailbox provider.
+var providerRules = map[string]providerRule{
+	// Google
+	"gmail.com":      googleRule,
+	"googlemail.com": googleRule,
+
+	// Microsoft
+	"outlook.com": microsoftRule,
+	"hotmail.com": microsoftRule,
+	"live.com":    microsoftRule,
+	"msn.com":     microsoftRule,
+
+	// Yahoo
+	"yahoo.com":      yahooRule,
+	"ymail.com":      yahooRule,
+	"rocketmail.com": yahooRule,
+
+	// Fastmail
+	"fastmail.com": fastmailRule,
+	"fastmail.fm":  fastmailRule,
+
+	// Apple
+	"icloud.com": appleRule,
+	"me.com":     appleRule,
+	"mac.com":    appleRule,
+
+	// Proton
+	"protonmail.com": protonRule,
+	"protonmail.ch":  protonRule,
+	"proton.me":      protonRule,
+	"pm.me":          protonRule,
+
+	// Zoho
+	"zoho.com":     zohoRule,
+	"zohomail.com": zohoRule,
+}
+
+// DomainAliases maps a domain to the domain it is an alias of. Both domains deliver to
+// the same mailbox, so ParseEmail reports the latter as the Domain. The domains must be
+// lower-case and in punycode. It can be modified during initialization. Use WithDomainAliases
+// if you require a different configuration.
+//
+// NOTE: Regional domains of some providers (e.g. hotmail.co.uk and hotmail.com) are separate
+// mailboxes and are therefore not aliases.
+var DomainAliases = map[string]string{
+	// Google
+	"googlemail.com": "gmail.com",
+
+	// Proton
+	"protonmail.ch": "protonmail.com",
+
+	// Yandex
+	"ya.ru":      "yandex.ru",
+	"yandex.by":  "yandex.ru",
+	"yandex.com": "yandex.ru",
+	"yandex.kz":  "yandex.ru",
+	"yandex.ua":  "yandex.ru",
+}
+
+// WithDomainAliases sets the domain aliases. The default is DomainAliases.
+func WithDomainAliases(aliases map[string]string) Option {
+	return func(cfg *config) {
+		cfg.aliases = aliases
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// WithPublicSuffixList uses the Public Suffix List (https://publicsuffix.org):
+//
+//   - Domains without a registrable domain are rejected (e.g. foo.localhost, bar.invalid or co.uk).
+//   - Only the domain and its parents up to the registrable domain (eTLD+1) are checked against
+//     the lists. For example, for mail.example.co.uk, co.uk is never checked.
+//
+// It is ignored for the parent domain checks if WithoutSubdomainMatching is used.
+func WithPublicSuffixList() Option {
+	return func(cfg *config) {
+		cfg.psl = true
+	}
+}
+
+// RegistrableDomain returns the registrable domain (eTLD+1) of domain using the Public Suffix List
+// (e.g. mail.example.co.uk => example.co.uk). domain must be lower-case and in punycode.
+//
+// ok is false if domain does not have a registrable domain, either because the top-level domain
+// does not exist (e.g. foo.localhost) or because domain is itself a public suffix (e.g. co.uk).
+func RegistrableDomain(domain string) (registrable string, ok bool) {
+	suffix, icann := publicsuffix.PublicSuffix(domain)
+	if !icann && strings.IndexByte(suffix, '.') == -1 {
+		// Not in the list: the default rule ("*") was applied
+		return "", false
+	}
+
+	registrable, err := publicsuffix.EffectiveTLDPlusOne(domain)
+	if err != nil {
+		return "", false
+	}
+	return registrable, true
+}
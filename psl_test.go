@@ -0,0 +1,67 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegistrableDomain(t *testing.T) {
+	tests := []struct {
+		domain      string
+		registrable string
+		ok          bool
+	}{
+		{"example.com", "example.com", true},
+		{"mail.example.co.uk", "example.co.uk", true},
+		{"co.uk", "", false},
+		{"foo.localhost", "", false},
+		{"bar.invalid", "", false},
+	}
+
+	for _, tt := range tests {
+		registrable, ok := RegistrableDomain(tt.domain)
+		if registrable != tt.registrable || ok != tt.ok {
+			t.Errorf("RegistrableDomain(%s) = %s, %v, want %s, %v", tt.domain, registrable, ok, tt.registrable, tt.ok)
+		}
+	}
+}
+
+func TestWithPublicSuffixList(t *testing.T) {
+	for _, email := range []string{"john@co.uk", "john@foo.localhost"} {
+		if _, err := ParseEmail(email, WithPublicSuffixList()); !errors.Is(err, ErrInvalidDomain) {
+			t.Errorf("%s: got %v, want ErrInvalidDomain", email, err)
+		}
+	}
+
+	tests := []struct {
+		list       []string
+		psl        bool
+		disposable bool
+	}{
+		// co.uk is above the registrable domain, so it is only checked without the PSL
+		{[]string{"co.uk"}, false, true},
+		{[]string{"co.uk"}, true, false},
+		{[]string{"example.co.uk"}, false, true},
+		{[]string{"example.co.uk"}, true, true},
+		{[]string{"other.co.uk"}, true, false},
+	}
+
+	for _, tt := range tests {
+		list := NewList(toSet(tt.list))
+		opts := []Option{WithList(list)}
+		if tt.psl {
+			opts = append(opts, WithPublicSuffixList())
+		}
+
+		p, err := ParseEmail("john@mail.example.co.uk", opts...)
+		if err != nil {
+			t.Errorf("%v (psl: %v): unexpected error: %v", tt.list, tt.psl, err)
+			continue
+		}
+		if p.Disposable != tt.disposable {
+			t.Errorf("%v (psl: %v): Disposable = %v, want %v", tt.list, tt.psl, p.Disposable, tt.disposable)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "strings"
+
+// RoleAccounts contains local-parts that belong to a role or function (e.g. admin or noreply)
+// rather than a person. They must be lower-case. It is used by ParseEmail and can be modified
+// during initialization. Use a Checker with WithRoles if you require a different configuration.
+var RoleAccounts = toRoleSet([]string{
+	"abuse",
+	"accounts",
+	"admin",
+	"administrator",
+	"billing",
+	"contact",
+	"do-not-reply",
+	"donotreply",
+	"help",
+	"hello",
+	"hostmaster",
+	"info",
+	"jobs",
+	"mail",
+	"mailer-daemon",
+	"marketing",
+	"no-reply",
+	"noreply",
+	"office",
+	"postmaster",
+	"privacy",
+	"root",
+	"sales",
+	"security",
+	"support",
+	"team",
+	"webmaster",
+})
+
+// WithRoles sets the local-parts that are considered role accounts. The default is RoleAccounts.
+func WithRoles(roles ...string) Option {
+	return func(cfg *config) {
+		cfg.roles = toRoleSet(roles)
+	}
+}
+
+func toRoleSet(roles []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		set[strings.ToLower(role)] = struct{}{}
+	}
+	return set
+}
+
+// isRole returns true if localPart (excluding any sub-address tag) is a role account.
+func (cfg *config) isRole(localPart string) bool {
+	if idx := strings.IndexByte(localPart, '+'); idx != -1 {
+		localPart = localPart[:idx]
+	}
+	_, exists := cfg.roles[strings.ToLower(localPart)]
+	return exists
+}
@@ -0,0 +1,31 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"errors"
+	"unicode"
+)
+
+// ErrUnsafeForSMTP is returned if the email address contains characters that could
+// be used to inject additional SMTP commands.
+var ErrUnsafeForSMTP = errors.New("email unsafe for smtp")
+
+// SafeForSMTP returns ErrUnsafeForSMTP if the email address contains characters that could
+// break out of an SMTP command such as RCPT TO:<...>. This includes CR, LF, all other control
+// characters, white-space and angle brackets.
+//
+// It is a security check and not a validation check. Use ParseEmail to validate the address.
+func SafeForSMTP(email string) error {
+	for _, r := range email {
+		switch r {
+		case '\r', '\n', '<', '>':
+			return ErrUnsafeForSMTP
+		default:
+			if unicode.IsControl(r) || unicode.IsSpace(r) || r == unicode.ReplacementChar {
+				return ErrUnsafeForSMTP
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "testing"
+
+func TestSafeForSMTP(t *testing.T) {
+	tests := []struct {
+		email string
+		safe  bool
+	}{
+		{"john.smith@gmail.com", true},
+		{"john+news@example.co.uk", true},
+		{"john@gmail.com\r\nRCPT TO:<victim@example.com>", false},
+		{"john@gmail.com\n", false},
+		{"john@gmail.com\r", false},
+		{"john@gmail.com>", false},
+		{"<john@gmail.com", false},
+		{"john smith@gmail.com", false},
+		{"john\x00@gmail.com", false},
+		{"john\xff@gmail.com", false},
+	}
+
+	for _, tt := range tests {
+		err := SafeForSMTP(tt.email)
+		if tt.safe && err != nil {
+			t.Errorf("SafeForSMTP(%q) = %v, want nil", tt.email, err)
+		}
+		if !tt.safe && err != ErrUnsafeForSMTP {
+			t.Errorf("SafeForSMTP(%q) = %v, want ErrUnsafeForSMTP", tt.email, err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// spoofableProviders is a list of well-known free email providers that abusers
+// like to embed in their own domains to look legitimate.
+var spoofableProviders = []string{
+	"aol.com",
+	"gmail.com",
+	"gmx.com",
+	"googlemail.com",
+	"hotmail.com",
+	"icloud.com",
+	"live.com",
+	"mail.ru",
+	"outlook.com",
+	"protonmail.com",
+	"yahoo.com",
+	"yandex.ru",
+	"zoho.com",
+}
+
+// spoofedProvider returns true if a well-known free email provider appears as labels
+// below the registrable domain (eTLD+1) of domain, and the registrable domain is not
+// the provider itself. domain must be lower-case and in punycode.
+//
+// Example: gmail.com.evil.tld is flagged, but yahoo.com.br and mail.gmail.com are not.
+func spoofedProvider(domain string) bool {
+	var found bool
+	for _, provider := range spoofableProviders {
+		if strings.Contains(domain, provider+".") {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+
+	// Unknown top-level domains are treated as public suffixes (e.g. evil.tld)
+	registrable, err := publicsuffix.EffectiveTLDPlusOne(domain)
+	if err != nil {
+		return false
+	}
+
+	// The labels below the registrable domain, with a leading and trailing '.'
+	sub := "." + domain[:len(domain)-len(registrable)]
+	if sub == "." {
+		return false
+	}
+
+	for _, provider := range spoofableProviders {
+		if provider != registrable && strings.Contains(sub, "."+provider+".") {
+			return true
+		}
+	}
+	return false
+}
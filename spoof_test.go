@@ -0,0 +1,36 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "testing"
+
+func TestSpoofedProvider(t *testing.T) {
+	tests := []struct {
+		email   string
+		spoofed bool
+	}{
+		{"x@gmail.com.evil.tld", true},
+		{"x@something.gmail.com.evil.tld", true},
+		{"x@gmail.com.evil.co.uk", true},
+		{"x@yahoo.com.br.evil.com", true},
+		{"x@gmail.com", false},
+		{"x@mail.gmail.com", false},
+		{"x@gmail.com.gmail.com", false},
+		{"x@yahoo.com.br", false},
+		{"x@yahoo.com.au", false},
+		{"x@hotmail.com.br", false},
+		{"x@notgmail.com.au", false},
+		{"x@example.com", false},
+	}
+
+	for _, tt := range tests {
+		p, err := ParseEmail(tt.email)
+		if err != nil {
+			t.Errorf("%s: %v", tt.email, err)
+			continue
+		}
+		if p.SpoofedProvider != tt.spoofed {
+			t.Errorf("%s: SpoofedProvider = %v, want %v", tt.email, p.SpoofedProvider, tt.spoofed)
+		}
+	}
+}
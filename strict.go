@@ -0,0 +1,126 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Length limits as per RFC 5321 (and its errata for the maximum path length).
+const (
+	maxEmailLen     = 254
+	maxLocalPartLen = 64
+	maxDomainLen    = 255
+	maxLabelLen     = 63
+)
+
+// WithStrictValidation enforces RFC 5321/5322 rules:
+//
+//   - The local-part must be at most 64 octets and the domain at most 255 octets.
+//   - The local-part must be a dot-atom (no leading, trailing or consecutive dots) or a
+//     quoted-string (e.g. "john doe"@example.com or "a@b"@example.com).
+//   - Each domain label must be 1 to 63 characters and consist of letters, digits and hyphens,
+//     without a leading or trailing hyphen.
+//
+// UTF-8 characters are permitted in the local-part as per RFC 6531.
+func WithStrictValidation() Option {
+	return func(cfg *config) {
+		cfg.strict = true
+	}
+}
+
+// validLocalPart returns true if localPart is a valid dot-atom or quoted-string.
+func validLocalPart(localPart string) bool {
+	if localPart == "" || len(localPart) > maxLocalPartLen || !utf8.ValidString(localPart) {
+		return false
+	}
+
+	if localPart[0] == '"' {
+		return validQuotedString(localPart)
+	}
+
+	return validDotAtom(localPart)
+}
+
+func validDotAtom(s string) bool {
+	if strings.HasPrefix(s, ".") || strings.HasSuffix(s, ".") || strings.Contains(s, "..") {
+		return false
+	}
+
+	for _, r := range s {
+		if r == '.' || isAtext(r) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func isAtext(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	case r >= 0x80:
+		// RFC 6531
+		return r != utf8.RuneError && !invisible(r)
+	}
+	return strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)
+}
+
+func validQuotedString(s string) bool {
+	if len(s) < 2 || s[len(s)-1] != '"' {
+		return false
+	}
+
+	s = s[1 : len(s)-1]
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 0x80 {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if unicode.IsControl(r) || invisible(r) {
+				return false
+			}
+			i += size - 1
+			continue
+		}
+
+		switch {
+		case c == '\\':
+			// quoted-pair
+			i++
+			if i == len(s) || s[i] < 0x20 && s[i] != '\t' || s[i] == 0x7F {
+				return false
+			}
+		case c == '"':
+			return false
+		case c < 0x20 && c != '\t', c == 0x7F:
+			return false
+		}
+	}
+	return true
+}
+
+// validStrictDomain returns true if domain (in punycode) satisfies the length and label rules.
+func validStrictDomain(domain string) bool {
+	if len(domain) > maxDomainLen {
+		return false
+	}
+
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > maxLabelLen {
+			return false
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
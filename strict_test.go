@@ -0,0 +1,58 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStrictValidation(t *testing.T) {
+	tests := []struct {
+		email string
+		err   error
+	}{
+		{"john.smith@example.com", nil},
+		{`"john doe"@example.com`, nil},
+		{`"a@b"@example.com`, nil},
+		{`"a\"b"@example.com`, nil},
+		{strings.Repeat("a", 64) + "@example.com", nil},
+		{"john@" + strings.Repeat("b", 63) + ".com", nil},
+		{".john@example.com", ErrInvalidLocalPart},
+		{"john.@example.com", ErrInvalidLocalPart},
+		{"john..smith@example.com", ErrInvalidLocalPart},
+		{"john doe@example.com", ErrInvalidLocalPart},
+		{`"john@example.com`, ErrInvalidLocalPart},
+		{`"a"b"@example.com`, ErrInvalidLocalPart},
+		{strings.Repeat("a", 65) + "@example.com", ErrInvalidLocalPart},
+		{"john@" + strings.Repeat("b", 64) + ".com", ErrInvalidDomain},
+		{"john@-example.com", ErrInvalidDomain},
+		{"john@example-.com", ErrInvalidDomain},
+		{"john@exa_mple.com", ErrInvalidDomain},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseEmail(tt.email, WithStrictValidation())
+		if tt.err == nil && err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.email, err)
+		} else if tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("%s: got %v, want %v", tt.email, err, tt.err)
+		}
+	}
+}
+
+func TestStrictValidationQuotedAtSign(t *testing.T) {
+	p, err := ParseEmail(`"a@b"@example.com`, WithStrictValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.LocalPart != `"a@b"` || p.Domain != "example.com" {
+		t.Errorf("got %s @ %s, want \"a@b\" @ example.com", p.LocalPart, p.Domain)
+	}
+
+	// Without strict validation, the quoted '@' is not permitted
+	if _, err := ParseEmail(`"a@b"@example.com`); !errors.Is(err, ErrMultipleAtSigns) {
+		t.Errorf("got %v, want ErrMultipleAtSigns", err)
+	}
+}
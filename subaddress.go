@@ -0,0 +1,57 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "strings"
+
+// SubaddressSeparators maps a domain to the characters that start a sub-address tag
+// (e.g. "+" for john+news@example.com). It overrides the built-in rules of major mailbox
+// providers. The key "*" applies to all other domains. The domains must be lower-case and in
+// punycode. It is used by ParseEmail and can be modified during initialization. Use
+// WithSubaddressSeparators if you require a different configuration.
+//
+// By default, tags are only extracted for major mailbox providers (e.g. gmail.com).
+var SubaddressSeparators = map[string]string{}
+
+// WithSubaddressSeparators sets the sub-address separators. The default is SubaddressSeparators.
+//
+// Example:
+//
+//	// Extract tags for all domains using '+' or '='
+//	disposable.WithSubaddressSeparators(map[string]string{"*": "+="})
+func WithSubaddressSeparators(separators map[string]string) Option {
+	return func(cfg *config) {
+		cfg.separators = separators
+	}
+}
+
+// WithoutTagStripping keeps the sub-address tag in Normalized (e.g. john+news@gmail.com
+// is normalized to john+news). Extra and Tags are still set.
+func WithoutTagStripping() Option {
+	return func(cfg *config) {
+		cfg.keepTags = true
+	}
+}
+
+// tagSeparators returns the sub-address separators of domain. providerSeparators
+// are the separators of the domain's mailbox provider (if known).
+func (cfg *config) tagSeparators(domain string, providerSeparators string, known bool) string {
+	if s, exists := cfg.separators[domain]; exists {
+		return s
+	}
+	if known {
+		return providerSeparators
+	}
+	return cfg.separators["*"]
+}
+
+// splitTags splits extra into the individual tags (e.g. a+b => [a b]).
+// Empty tags are omitted.
+func splitTags(extra, separators string) []string {
+	if extra == "" {
+		return nil
+	}
+	return strings.FieldsFunc(extra, func(r rune) bool {
+		return strings.ContainsRune(separators, r)
+	})
+}
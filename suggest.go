@@ -0,0 +1,138 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+// PopularDomains is the list of popular email domains used by Suggest, ordered by
+// popularity. It can be modified during initialization.
+var PopularDomains = []string{
+	"gmail.com",
+	"yahoo.com",
+	"hotmail.com",
+	"outlook.com",
+	"aol.com",
+	"icloud.com",
+	"live.com",
+	"msn.com",
+	"googlemail.com",
+	"hotmail.co.uk",
+	"yahoo.co.uk",
+	"protonmail.com",
+	"proton.me",
+	"mail.com",
+	"gmx.com",
+	"gmx.de",
+	"web.de",
+	"mail.ru",
+	"yandex.ru",
+	"zoho.com",
+	"comcast.net",
+	"verizon.net",
+	"att.net",
+	"sbcglobal.net",
+	"btinternet.com",
+}
+
+// WithSuggestions sets ParsedEmail.Suggestion if the domain appears to be a misspelling
+// of a popular email domain. See Suggest.
+//
+// It is not enabled by default because it is considerably more expensive than the other checks.
+// Decide enables it unless the policy accepts ReasonTypoSuspected.
+func WithSuggestions() Option {
+	return func(cfg *config) {
+		cfg.suggest = true
+	}
+}
+
+// Suggest returns the popular domain that domain is most likely a misspelling of
+// (e.g. gamil.com => gmail.com). domain must be lower-case and in punycode. It returns
+// an empty string if domain is a popular domain, a free webmail domain (see ActiveFreeProviderList)
+// or is not similar to any of the popular domains.
+//
+// Domains shorter than 8 characters may differ by 1 edit. Longer domains may differ by 2.
+// An edit is an insertion, deletion, substitution or transposition of adjacent characters.
+func Suggest(domain string) string {
+	return suggest(domain, ActiveFreeProviderList)
+}
+
+func suggest(domain string, free Lookup) string {
+
+	// Legitimate domains of free providers are often similar to each other (e.g. yahoo.ca and yahoo.com)
+	if free != nil && free.Contains(domain) {
+		return ""
+	}
+
+	maxDist := 1
+	if len(domain) >= 8 {
+		maxDist = 2
+	}
+
+	var (
+		suggestion string
+		best       = maxDist + 1
+	)
+
+	for _, popular := range PopularDomains {
+		if domain == popular {
+			return ""
+		}
+
+		diff := len(domain) - len(popular)
+		if diff > maxDist || -diff > maxDist {
+			continue
+		}
+
+		if d := editDistance(domain, popular); d < best {
+			suggestion, best = popular, d
+		}
+	}
+
+	return suggestion
+}
+
+// editDistance returns the optimal string alignment distance between a and b.
+func editDistance(a, b string) int {
+
+	// Three rows are needed to detect transpositions. For typical domains,
+	// they are backed by an array on the stack.
+	var arr [3 * 64]int
+	n := len(b) + 1
+
+	buf := arr[:]
+	if 3*n > len(arr) {
+		buf = make([]int, 3*n)
+	}
+	prev2, prev, curr := buf[:n], buf[n:2*n], buf[2*n:3*n]
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && prev2[j-2]+1 < curr[j] {
+				curr[j] = prev2[j-2] + 1
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
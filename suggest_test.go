@@ -0,0 +1,57 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := map[string]string{
+		"gamil.com":     "gmail.com",
+		"gmial.com":     "gmail.com",
+		"hotnail.com":   "hotmail.com",
+		"yaho.com":      "yahoo.com",
+		"outlok.com":    "outlook.com",
+		"gmail.com":     "",
+		"yahoo.ca":      "",
+		"yahoo.co.jp":   "",
+		"yahoo.co.in":   "",
+		"hotmail.de":    "",
+		"example.com":   "",
+		"acme-corp.com": "",
+	}
+
+	for domain, want := range tests {
+		if got := Suggest(domain); got != want {
+			t.Errorf("Suggest(%q) = %q, want %q", domain, got, want)
+		}
+	}
+}
+
+func TestWithSuggestions(t *testing.T) {
+	p, err := ParseEmail("john@gamil.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Suggestion != "" {
+		t.Errorf("Suggestion = %q without WithSuggestions, want empty", p.Suggestion)
+	}
+
+	p, err = ParseEmail("john@gamil.com", WithSuggestions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Suggestion != "gmail.com" {
+		t.Errorf("Suggestion = %q, want gmail.com", p.Suggestion)
+	}
+
+	// Regional domains of free providers must not be flagged as typos
+	for _, email := range []string{"john@yahoo.ca", "john@yahoo.co.jp", "john@yahoo.co.in"} {
+		d := Decide(context.Background(), email, WithSuggestions())
+		if d.Action != Accept {
+			t.Errorf("%s: Decide() = %v %v, want accept", email, d.Action, d.Reasons)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"io"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// ErrInvalidBinary is returned if the binary blocklist is malformed.
+var ErrInvalidBinary = disposable.ErrInvalidBinary
+
+// WriteBinary serializes list into a compact binary format that can be loaded
+// quickly with UpdateFromBinary. It is intended to be used during a build step.
+// See disposable.WriteBinary.
+func WriteBinary(w io.Writer, list map[string]struct{}) error {
+	return disposable.WriteBinary(w, list)
+}
+
+// UpdateFromBinary replaces the domains in list with the domains found in r, which must be
+// in the format produced by WriteBinary. The returned Summary describes the changes made to list.
+// If r contains no domains, ErrEmptyList is returned and list is not modified.
+func UpdateFromBinary(r io.Reader, list *disposable.List) (Summary, error) {
+	newList, err := disposable.ReadBinary(r)
+	if err != nil {
+		return Summary{}, err
+	}
+	return swap(list, newList, "")
+}
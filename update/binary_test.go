@@ -0,0 +1,80 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"testing"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+func TestUpdateFromBinary(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, disposable.DisposableList); err != nil {
+		t.Fatal(err)
+	}
+
+	list := disposable.NewList(map[string]struct{}{"not-in-list.example": {}})
+
+	s, err := UpdateFromBinary(&buf, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if list.Len() != len(disposable.DisposableList) || s.Total != list.Len() {
+		t.Fatalf("got %d domains, want %d", list.Len(), len(disposable.DisposableList))
+	}
+	for domain := range disposable.DisposableList {
+		if !list.Contains(domain) {
+			t.Errorf("%s missing after round trip", domain)
+		}
+	}
+	if len(s.Removed) != 1 || s.Removed[0] != "not-in-list.example" {
+		t.Errorf("Removed = %v, want [not-in-list.example]", s.Removed)
+	}
+}
+
+// benchmarkLists returns the bundled list in the binary and line formats.
+func benchmarkLists(b *testing.B) (bin, lines []byte) {
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, disposable.DisposableList); err != nil {
+		b.Fatal(err)
+	}
+
+	domains := make([]string, 0, len(disposable.DisposableList))
+	for domain := range disposable.DisposableList {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	return buf.Bytes(), []byte(strings.Join(domains, "\n"))
+}
+
+func BenchmarkReadBinary(b *testing.B) {
+	bin, _ := benchmarkLists(b)
+	b.SetBytes(int64(len(bin)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := disposable.ReadBinary(bytes.NewReader(bin)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseList(b *testing.B) {
+	_, lines := benchmarkLists(b)
+	b.SetBytes(int64(len(lines)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parseList(bytes.NewReader(lines)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
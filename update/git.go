@@ -0,0 +1,63 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !nogit
+// +build !nogit
+
+package update
+
+import (
+	"context"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// Fetch implements the Source interface. The version is the commit hash.
+func (s GitSource) Fetch(ctx context.Context) (map[string]struct{}, string, error) {
+
+	url := s.URL
+	if url == "" {
+		url = "https://github.com/martenson/disposable-email-domains"
+	}
+
+	path := s.Path
+	if path == "" {
+		path = "disposable_email_blocklist.conf"
+	}
+
+	fs := memfs.New()
+
+	opts := &git.CloneOptions{
+		URL:   url,
+		Depth: 0,
+	}
+
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), fs, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var version string
+	if head, err := repo.Head(); err == nil {
+		version = head.Hash().String()
+	}
+
+	file, err := fs.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	list, err := parseVerified(ctx, file, s.Verifier)
+	if err != nil {
+		file.Close()
+		return nil, "", err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return list, version, nil
+}
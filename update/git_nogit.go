@@ -0,0 +1,14 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build nogit
+// +build nogit
+
+package update
+
+import "context"
+
+// Fetch implements the Source interface. It always returns ErrGitUnavailable because
+// the 'nogit' build tag was used.
+func (s GitSource) Fetch(ctx context.Context) (map[string]struct{}, string, error) {
+	return nil, "", ErrGitUnavailable
+}
@@ -0,0 +1,130 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// RawURL is the URL of the raw list of disposable email domains.
+const RawURL = "https://raw.githubusercontent.com/disposable-email-domains/disposable-email-domains/main/disposable_email_blocklist.conf"
+
+// FreeProviderURL is the URL of the raw list of free webmail domains.
+// It can be used with HTTPSource or HTTPUpdater to update disposable.ActiveFreeProviderList.
+//
+// See: https://github.com/willwhite/freemail
+const FreeProviderURL = "https://raw.githubusercontent.com/willwhite/freemail/master/data/free.txt"
+
+// HTTPUpdater updates the list of disposable email domains by downloading the raw list over HTTP.
+// It is much lighter than Update, which clones the entire git repository.
+//
+// The ETag and Last-Modified headers of the previous response are remembered, so the list
+// is only downloaded when it has changed.
+//
+// An HTTPUpdater is safe for concurrent use. The zero value is ready to use.
+type HTTPUpdater struct {
+	// URL of the raw list. If empty, RawURL is used.
+	URL string
+
+	// Client is used to make the request. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// FallbackToGit falls back to cloning the git repository (see Update) if the HTTP request fails.
+	// Verifier is also used for the fallback. It is ignored if the 'nogit' build tag was used.
+	FallbackToGit bool
+
+	// Verifier verifies the list before it is used. If verification fails, list is left
+	// untouched and an error wrapping ErrVerificationFailed is returned. If nil, it is not verified.
+	Verifier Verifier
+
+	mu           sync.Mutex
+	etag         string
+	lastModified string
+}
+
+// Update updates list. The returned Summary describes the changes made to list.
+// If the list has not changed since the previous call, list is left untouched.
+func (u *HTTPUpdater) Update(ctx context.Context, list *disposable.List) (Summary, error) {
+	s, err := u.update(ctx, list)
+	if err != nil && u.FallbackToGit {
+		gs, gitErr := UpdateFromSource(ctx, GitSource{Verifier: u.Verifier}, list)
+		if gitErr != ErrGitUnavailable {
+			return gs, gitErr
+		}
+	}
+	return s, err
+}
+
+func (u *HTTPUpdater) update(ctx context.Context, list *disposable.List) (Summary, error) {
+
+	url := u.URL
+	if url == "" {
+		url = RawURL
+	}
+
+	client := u.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Summary{}, err
+	}
+	req = req.WithContext(ctx)
+
+	u.mu.Lock()
+	if u.etag != "" {
+		req.Header.Set("If-None-Match", u.etag)
+	}
+	if u.lastModified != "" {
+		req.Header.Set("If-Modified-Since", u.lastModified)
+	}
+	u.mu.Unlock()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Summary{}, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return u.unchanged(list), nil
+	default:
+		return Summary{}, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	newList, err := parseVerified(ctx, resp.Body, u.Verifier)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	etag := resp.Header.Get("ETag")
+
+	s, err := swap(list, newList, etag)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	// Only cache the validators once the list has been replaced
+	u.mu.Lock()
+	u.etag = etag
+	u.lastModified = resp.Header.Get("Last-Modified")
+	u.mu.Unlock()
+
+	return s, nil
+}
+
+func (u *HTTPUpdater) unchanged(list *disposable.List) Summary {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return Summary{Total: list.Len(), Source: u.etag}
+}
@@ -0,0 +1,146 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Notification describes the outcome of an update performed by a Runner.
+type Notification struct {
+	// Summary describes the changes made to the list. It is empty if Err is not nil.
+	Summary Summary
+
+	// Err is the error if the update failed.
+	Err error
+
+	// Time is when the update finished.
+	Time time.Time
+}
+
+// Notifier is notified after every update performed by a Runner. See WithNotifiers.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// NotifierFunc is a function that implements the Notifier interface.
+type NotifierFunc func(ctx context.Context, n Notification) error
+
+// Notify implements the Notifier interface.
+func (f NotifierFunc) Notify(ctx context.Context, n Notification) error {
+	return f(ctx, n)
+}
+
+// ChannelNotifier returns a Notifier that sends notifications to ch. If ch is not ready
+// to receive, the notification is dropped so the Runner is never blocked.
+func ChannelNotifier(ch chan<- Notification) Notifier {
+	return NotifierFunc(func(ctx context.Context, n Notification) error {
+		select {
+		case ch <- n:
+		default:
+		}
+		return nil
+	})
+}
+
+// WebhookNotifier is a Notifier that POSTs each notification as JSON to a URL.
+//
+// The default payload is:
+//
+//	{
+//	  "changed": true,
+//	  "added": ["new-domain.com"],
+//	  "removed": [],
+//	  "total": 3419,
+//	  "source": "...",
+//	  "error": "",
+//	  "time": "2022-01-02T15:04:05Z"
+//	}
+//
+// Use Payload to adapt it for services that expect a specific format (e.g. Slack).
+type WebhookNotifier struct {
+	// URL of the webhook.
+	URL string
+
+	// Client is used to make the request. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Payload returns the value that is encoded as JSON. If nil, the default payload is used.
+	Payload func(n Notification) interface{}
+
+	// OnlyChanges only notifies if the update failed or the list changed.
+	OnlyChanges bool
+}
+
+type webhookPayload struct {
+	Changed bool     `json:"changed"`
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Total   int      `json:"total"`
+	Source  string   `json:"source"`
+	Error   string   `json:"error"`
+	Time    string   `json:"time"`
+}
+
+// Notify implements the Notifier interface.
+func (w WebhookNotifier) Notify(ctx context.Context, n Notification) error {
+	if w.OnlyChanges && n.Err == nil && !n.Summary.Changed() {
+		return nil
+	}
+
+	var payload interface{}
+	if w.Payload != nil {
+		payload = w.Payload(n)
+	} else {
+		p := webhookPayload{
+			Changed: n.Summary.Changed(),
+			Added:   n.Summary.Added,
+			Removed: n.Summary.Removed,
+			Total:   n.Summary.Total,
+			Source:  n.Summary.Source,
+			Time:    n.Time.UTC().Format(time.RFC3339),
+		}
+		if p.Added == nil {
+			p.Added = []string{}
+		}
+		if p.Removed == nil {
+			p.Removed = []string{}
+		}
+		if n.Err != nil {
+			p.Error = n.Err.Error()
+		}
+		payload = p
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook: unexpected status: %s", resp.Status)
+	}
+	return nil
+}
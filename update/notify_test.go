@@ -0,0 +1,91 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var (
+		status = http.StatusOK
+		bodies []map[string]interface{}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); r.Method != http.MethodPost || ct != "application/json" {
+			t.Errorf("got %s with Content-Type %s, want POST with application/json", r.Method, ct)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		var body map[string]interface{}
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Errorf("invalid payload %s: %v", b, err)
+		}
+		bodies = append(bodies, body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	at := time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC)
+	n := WebhookNotifier{URL: srv.URL, OnlyChanges: true}
+
+	// Changed
+	err := n.Notify(ctx, Notification{Summary: Summary{Added: []string{"new.com"}, Total: 3, Source: "v2"}, Time: at})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"changed": true,
+		"added":   []interface{}{"new.com"},
+		"removed": []interface{}{},
+		"total":   float64(3),
+		"source":  "v2",
+		"error":   "",
+		"time":    "2022-01-02T15:04:05Z",
+	}
+	if len(bodies) != 1 || !reflect.DeepEqual(bodies[0], want) {
+		t.Fatalf("got %v, want %v", bodies, want)
+	}
+
+	// Unchanged is skipped with OnlyChanges
+	if err := n.Notify(ctx, Notification{Summary: Summary{Total: 3}, Time: at}); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 1 {
+		t.Errorf("got %d requests, want 1", len(bodies))
+	}
+
+	// Failed updates are always notified
+	if err := n.Notify(ctx, Notification{Err: errors.New("boom"), Time: at}); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 2 || bodies[1]["error"] != "boom" || bodies[1]["changed"] != false {
+		t.Errorf("got %v, want error boom", bodies[len(bodies)-1])
+	}
+
+	// Custom payload
+	n.Payload = func(n Notification) interface{} {
+		return map[string]string{"text": "list updated"}
+	}
+	if err := n.Notify(ctx, Notification{Summary: Summary{Removed: []string{"old.com"}}, Time: at}); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 3 || !reflect.DeepEqual(bodies[2], map[string]interface{}{"text": "list updated"}) {
+		t.Errorf("got %v, want custom payload", bodies[len(bodies)-1])
+	}
+
+	// Non-2xx status
+	status = http.StatusInternalServerError
+	if err := n.Notify(ctx, Notification{Err: errors.New("boom"), Time: at}); err == nil {
+		t.Error("expected error for status 500")
+	}
+}
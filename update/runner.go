@@ -0,0 +1,216 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// MinInterval is the minimum interval between updates performed by a Runner.
+const MinInterval = time.Minute
+
+// UpdateFunc updates list. Update has this signature.
+type UpdateFunc func(ctx context.Context, list *disposable.List) (Summary, error)
+
+// Option configures a Runner.
+type Option func(*runnerConfig)
+
+type runnerConfig struct {
+	list       *disposable.List
+	fn         UpdateFunc
+	jitter     float64
+	minBackoff time.Duration
+	onSuccess  func(Summary)
+	onError    func(error)
+	persist    string
+	metrics    disposable.Metrics
+	notifiers  []Notifier
+}
+
+// WithTarget sets the list to update. The default is disposable.ActiveList.
+func WithTarget(list *disposable.List) Option {
+	return func(cfg *runnerConfig) {
+		cfg.list = list
+	}
+}
+
+// WithUpdateFunc sets the function used to update the list.
+// The default uses an HTTPUpdater that falls back to Update.
+func WithUpdateFunc(fn UpdateFunc) Option {
+	return func(cfg *runnerConfig) {
+		cfg.fn = fn
+	}
+}
+
+// WithJitter randomly varies each interval by up to ±fraction (e.g. 0.1 for 10%).
+// The default is 0.1.
+func WithJitter(fraction float64) Option {
+	return func(cfg *runnerConfig) {
+		cfg.jitter = fraction
+	}
+}
+
+// WithBackoff sets the initial retry interval after a failed update. It doubles
+// after every consecutive failure until it reaches the regular interval.
+// The default is 1 minute.
+func WithBackoff(d time.Duration) Option {
+	return func(cfg *runnerConfig) {
+		cfg.minBackoff = d
+	}
+}
+
+// WithPersist saves the list to path (see disposable.List.Save) after every successful update,
+// so it can be loaded with disposable.LoadList when the service restarts. A failure to save
+// is reported to OnError, but the update is still considered successful.
+func WithPersist(path string) Option {
+	return func(cfg *runnerConfig) {
+		cfg.persist = path
+	}
+}
+
+// WithMetrics sets the Metrics that is notified after every update attempt.
+// The default is disposable.DefaultMetrics.
+func WithMetrics(m disposable.Metrics) Option {
+	return func(cfg *runnerConfig) {
+		cfg.metrics = m
+	}
+}
+
+// WithNotifiers notifies each Notifier after every update, successful or not.
+// A Notifier that fails is reported to OnError.
+func WithNotifiers(notifiers ...Notifier) Option {
+	return func(cfg *runnerConfig) {
+		cfg.notifiers = append(cfg.notifiers, notifiers...)
+	}
+}
+
+// OnSuccess is called after every successful update with a summary of the changes.
+func OnSuccess(fn func(Summary)) Option {
+	return func(cfg *runnerConfig) {
+		cfg.onSuccess = fn
+	}
+}
+
+// OnError is called after every failed update.
+func OnError(fn func(error)) Option {
+	return func(cfg *runnerConfig) {
+		cfg.onError = fn
+	}
+}
+
+// Runner periodically updates the list of disposable email domains.
+type Runner struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// StartAutoUpdate starts a Runner that updates the list immediately and then
+// every interval, until ctx is cancelled or Stop is called. An interval shorter than
+// MinInterval (including zero or negative) is raised to MinInterval.
+func StartAutoUpdate(ctx context.Context, interval time.Duration, opts ...Option) *Runner {
+
+	if interval < MinInterval {
+		interval = MinInterval
+	}
+
+	cfg := runnerConfig{
+		list:       disposable.ActiveList,
+		jitter:     0.1,
+		minBackoff: time.Minute,
+		metrics:    disposable.DefaultMetrics,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.fn == nil {
+		u := &HTTPUpdater{FallbackToGit: true}
+		cfg.fn = u.Update
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	r := &Runner{cancel: cancel, done: make(chan struct{})}
+
+	go r.run(ctx, interval, cfg)
+
+	return r
+}
+
+// Stop stops the Runner and waits for any in-progress update to finish.
+func (r *Runner) Stop() {
+	r.cancel()
+	<-r.done
+}
+
+func (r *Runner) run(ctx context.Context, interval time.Duration, cfg runnerConfig) {
+	defer close(r.done)
+
+	backoff := cfg.minBackoff
+
+	for {
+		wait := interval
+
+		start := time.Now()
+		s, err := cfg.fn(ctx, cfg.list)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if cfg.metrics != nil {
+				cfg.metrics.Updated(cfg.list.Len(), time.Since(start), err)
+			}
+			if cfg.onError != nil {
+				cfg.onError(err)
+			}
+
+			if backoff > 0 && backoff < interval {
+				wait = backoff
+				backoff *= 2
+			}
+			s = Summary{}
+		} else {
+			backoff = cfg.minBackoff
+			if cfg.metrics != nil {
+				cfg.metrics.Updated(cfg.list.Len(), time.Since(start), nil)
+			}
+
+			// The list has already been updated, so a failure to save it is not a failed update
+			if cfg.persist != "" {
+				if err := cfg.list.Save(cfg.persist); err != nil && cfg.onError != nil {
+					cfg.onError(err)
+				}
+			}
+
+			if cfg.onSuccess != nil {
+				cfg.onSuccess(s)
+			}
+		}
+
+		cfg.notify(ctx, Notification{Summary: s, Err: err, Time: time.Now()})
+
+		if cfg.jitter > 0 {
+			wait += time.Duration((rand.Float64()*2 - 1) * cfg.jitter * float64(wait))
+		}
+
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (cfg *runnerConfig) notify(ctx context.Context, n Notification) {
+	for _, notifier := range cfg.notifiers {
+		err := notifier.Notify(ctx, n)
+		if err != nil && cfg.onError != nil && ctx.Err() == nil {
+			cfg.onError(err)
+		}
+	}
+}
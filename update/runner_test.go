@@ -0,0 +1,88 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+func TestStartAutoUpdateMinInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Hour, time.Nanosecond} {
+		var calls atomic.Int32
+		fn := func(ctx context.Context, list *disposable.List) (Summary, error) {
+			calls.Add(1)
+			return Summary{}, nil
+		}
+
+		r := StartAutoUpdate(context.Background(), interval,
+			WithTarget(disposable.NewList(nil)),
+			WithUpdateFunc(fn),
+			WithJitter(0),
+			WithMetrics(nil),
+		)
+		time.Sleep(50 * time.Millisecond)
+		r.Stop()
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("interval %v: %d updates, want 1", interval, n)
+		}
+	}
+}
+
+func TestStartAutoUpdatePersistFailure(t *testing.T) {
+	var calls atomic.Int32
+	fn := func(ctx context.Context, list *disposable.List) (Summary, error) {
+		calls.Add(1)
+		list.Store(map[string]struct{}{"new.com": {}})
+		return Summary{Added: []string{"new.com"}, Total: 1}, nil
+	}
+
+	var (
+		errs      = make(chan error, 10)
+		successes = make(chan Summary, 10)
+		notified  = make(chan Notification, 10)
+	)
+
+	r := StartAutoUpdate(context.Background(), time.Hour,
+		WithTarget(disposable.NewList(nil)),
+		WithUpdateFunc(fn),
+		WithPersist(filepath.Join(t.TempDir(), "missing", "list.bin")),
+		WithBackoff(time.Millisecond),
+		WithMetrics(nil),
+		WithNotifiers(ChannelNotifier(notified)),
+		OnError(func(err error) { errs <- err }),
+		OnSuccess(func(s Summary) { successes <- s }),
+	)
+	time.Sleep(50 * time.Millisecond)
+	r.Stop()
+
+	want := []string{"new.com"}
+
+	if len(errs) != 1 {
+		t.Errorf("got %d errors, want 1 (the failure to save)", len(errs))
+	}
+	if len(successes) != 1 {
+		t.Fatalf("got %d successful updates, want 1", len(successes))
+	}
+	if s := <-successes; !reflect.DeepEqual(s.Added, want) {
+		t.Errorf("OnSuccess: Added = %v, want %v", s.Added, want)
+	}
+	if len(notified) != 1 {
+		t.Fatalf("got %d notifications, want 1", len(notified))
+	}
+	if n := <-notified; n.Err != nil || !reflect.DeepEqual(n.Summary.Added, want) {
+		t.Errorf("notification: Err = %v, Added = %v, want nil, %v", n.Err, n.Summary.Added, want)
+	}
+
+	// No backoff: the update must not be retried
+	if n := calls.Load(); n != 1 {
+		t.Errorf("%d updates, want 1", n)
+	}
+}
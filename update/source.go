@@ -0,0 +1,202 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// ErrGitUnavailable is returned by GitSource if the 'nogit' build tag was used.
+var ErrGitUnavailable = errors.New("git support disabled by the nogit build tag")
+
+// ErrAlreadyFetched is returned by a Source created with ReaderSource if it is fetched more than once.
+var ErrAlreadyFetched = errors.New("source already fetched")
+
+// Source provides a list of domains.
+type Source interface {
+	// Fetch returns the domains along with an identifier of their version (which may be empty).
+	Fetch(ctx context.Context) (domains map[string]struct{}, version string, err error)
+}
+
+// GitSource fetches the list from a git repository. The zero value uses the
+// upstream disposable-email-domains repository.
+//
+// NOTE: If built with the 'nogit' build tag, go-git is not linked into the binary
+// and Fetch returns ErrGitUnavailable.
+type GitSource struct {
+	// URL of the repository.
+	URL string
+
+	// Path of the file within the repository.
+	Path string
+
+	// Verifier verifies the file before it is used. If nil, it is not verified.
+	Verifier Verifier
+}
+
+// HTTPSource fetches the list over HTTP. The zero value uses RawURL.
+type HTTPSource struct {
+	// URL of the raw list.
+	URL string
+
+	// Client is used to make the request. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Verifier verifies the list before it is used. If nil, it is not verified.
+	Verifier Verifier
+}
+
+// Fetch implements the Source interface. The version is the ETag (if provided by the server).
+func (s HTTPSource) Fetch(ctx context.Context) (map[string]struct{}, string, error) {
+
+	url := s.URL
+	if url == "" {
+		url = RawURL
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	list, err := parseVerified(ctx, resp.Body, s.Verifier)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return list, resp.Header.Get("ETag"), nil
+}
+
+// FileSource fetches the list from a local file with one domain per line.
+type FileSource string
+
+// Fetch implements the Source interface.
+func (s FileSource) Fetch(ctx context.Context) (map[string]struct{}, string, error) {
+	file, err := os.Open(string(s))
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	list, err := parseList(file)
+	if err != nil {
+		return nil, "", err
+	}
+	return list, "", nil
+}
+
+// ReaderSource returns a Source that reads the list from r, with one domain per line.
+// r can only be read once, so the Source can only be fetched once. Subsequent fetches
+// return ErrAlreadyFetched.
+func ReaderSource(r io.Reader) Source {
+	return &readerSource{r: r}
+}
+
+type readerSource struct {
+	mu      sync.Mutex
+	r       io.Reader
+	fetched bool
+}
+
+func (s *readerSource) Fetch(ctx context.Context) (map[string]struct{}, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fetched {
+		return nil, "", ErrAlreadyFetched
+	}
+	s.fetched = true
+
+	list, err := parseList(s.r)
+	if err != nil {
+		return nil, "", err
+	}
+	return list, "", nil
+}
+
+// Allow wraps src so that its domains are removed from the result of Merge.
+// Allowed domains take precedence over all other sources.
+func Allow(src Source) Source {
+	return allowSource{src}
+}
+
+type allowSource struct {
+	Source
+}
+
+// Merge returns a Source that combines the domains of all sources. Domains from
+// sources wrapped with Allow are then removed. The sources are fetched sequentially
+// and the Source fails if any of them fail.
+//
+// The version is the non-empty versions of all sources joined by a comma.
+func Merge(sources ...Source) Source {
+	return mergeSource(sources)
+}
+
+type mergeSource []Source
+
+func (m mergeSource) Fetch(ctx context.Context) (map[string]struct{}, string, error) {
+
+	merged := map[string]struct{}{}
+	allowed := map[string]struct{}{}
+	versions := make([]string, 0, len(m))
+
+	for _, src := range m {
+		list, version, err := src.Fetch(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		if version != "" {
+			versions = append(versions, version)
+		}
+
+		dst := merged
+		if _, ok := src.(allowSource); ok {
+			dst = allowed
+		}
+		for domain := range list {
+			dst[domain] = struct{}{}
+		}
+	}
+
+	for domain := range allowed {
+		delete(merged, domain)
+	}
+
+	return merged, strings.Join(versions, ","), nil
+}
+
+// UpdateFromSource replaces the domains in list with the domains provided by src.
+// The returned Summary describes the changes made to list. If src provides no domains,
+// ErrEmptyList is returned and list is not modified.
+func UpdateFromSource(ctx context.Context, src Source, list *disposable.List) (Summary, error) {
+	newList, version, err := src.Fetch(ctx)
+	if err != nil {
+		return Summary{}, err
+	}
+	return swap(list, newList, version)
+}
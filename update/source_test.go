@@ -0,0 +1,102 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+func TestReaderSourceFetchedOnce(t *testing.T) {
+	ctx := context.Background()
+	list := disposable.NewList(nil)
+
+	src := ReaderSource(strings.NewReader("a.com\nb.com\n"))
+
+	s, err := UpdateFromSource(ctx, src, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s.Added, []string{"a.com", "b.com"}) {
+		t.Errorf("Added = %v, want [a.com b.com]", s.Added)
+	}
+
+	if _, err := UpdateFromSource(ctx, src, list); err != ErrAlreadyFetched {
+		t.Errorf("second fetch: got %v, want ErrAlreadyFetched", err)
+	}
+	if list.Len() != 2 {
+		t.Errorf("Len() = %d after second fetch, want 2", list.Len())
+	}
+}
+
+func TestUpdateFromSourceEmpty(t *testing.T) {
+	list := disposable.NewList(map[string]struct{}{"a.com": {}})
+
+	for _, content := range []string{"", "\n# only comments\n\n"} {
+		if _, err := UpdateFromSource(context.Background(), ReaderSource(strings.NewReader(content)), list); err != ErrEmptyList {
+			t.Errorf("%q: got %v, want ErrEmptyList", content, err)
+		}
+	}
+
+	if list.Len() != 1 || !list.Contains("a.com") {
+		t.Errorf("list was modified: %v", list.Load())
+	}
+}
+
+func TestHTTPUpdaterEmpty(t *testing.T) {
+	var body string
+	var gotETag []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotETag = append(gotETag, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	list := disposable.NewList(map[string]struct{}{"a.com": {}})
+	u := &HTTPUpdater{URL: srv.URL}
+
+	if _, err := u.Update(context.Background(), list); err != ErrEmptyList {
+		t.Fatalf("got %v, want ErrEmptyList", err)
+	}
+	if list.Len() != 1 {
+		t.Errorf("list was modified: %v", list.Load())
+	}
+
+	// The ETag of the empty response must not be cached
+	body = "b.com\n"
+	if _, err := u.Update(context.Background(), list); err != nil {
+		t.Fatal(err)
+	}
+	if gotETag[1] != "" {
+		t.Errorf("If-None-Match = %s, want empty", gotETag[1])
+	}
+	if list.Len() != 1 || !list.Contains("b.com") {
+		t.Errorf("got %v, want [b.com]", list.Load())
+	}
+}
+
+func TestMerge(t *testing.T) {
+	src := Merge(
+		ReaderSource(strings.NewReader("a.com\nb.com\n")),
+		ReaderSource(strings.NewReader("c.com\n")),
+		Allow(ReaderSource(strings.NewReader("b.com\n"))),
+	)
+
+	domains, _, err := src.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]struct{}{"a.com": {}, "c.com": {}}
+	if !reflect.DeepEqual(domains, want) {
+		t.Errorf("got %v, want %v", domains, want)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"errors"
+	"sort"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// Summary describes the changes made to the list by an update.
+type Summary struct {
+	// Added contains the domains that were added, sorted.
+	Added []string
+
+	// Removed contains the domains that were removed, sorted.
+	Removed []string
+
+	// Total is the number of domains in the list after the update.
+	Total int
+
+	// Source identifies the version of the list. It is the commit hash for Update
+	// and the ETag (if provided by the server) for HTTPUpdater.
+	Source string
+}
+
+// Changed returns true if any domains were added or removed.
+func (s Summary) Changed() bool {
+	return len(s.Added) > 0 || len(s.Removed) > 0
+}
+
+// ErrEmptyList is returned if an update provides no domains. The list is not replaced,
+// since an empty list (e.g. due to an empty response) would silently disable blocking.
+var ErrEmptyList = errors.New("empty list")
+
+// swap replaces the domains in list with newList and reports the differences.
+func swap(list *disposable.List, newList map[string]struct{}, source string) (Summary, error) {
+
+	if len(newList) == 0 {
+		return Summary{}, ErrEmptyList
+	}
+
+	old := list.SwapSource(newList, source)
+
+	s := Summary{Total: len(newList), Source: source}
+
+	for domain := range newList {
+		if _, exists := old[domain]; !exists {
+			s.Added = append(s.Added, domain)
+		}
+	}
+	for domain := range old {
+		if _, exists := newList[domain]; !exists {
+			s.Removed = append(s.Removed, domain)
+		}
+	}
+	sort.Strings(s.Added)
+	sort.Strings(s.Removed)
+
+	return s, nil
+}
@@ -5,57 +5,38 @@ package update
 import (
 	"bufio"
 	"context"
-	"sync"
+	"io"
+	"strings"
 
-	"github.com/go-git/go-billy/v5/memfs"
-	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/storage/memory"
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
 )
 
 // Update can be used to update the list of disposable email domains.
 // It uses the regularly updated list found here: https://github.com/martenson/disposable-email-domains.
-func Update(ctx context.Context, list *map[string]struct{}, lock ...sync.Locker) error {
-
-	fs := memfs.New()
-
-	opts := &git.CloneOptions{
-		URL:   "https://github.com/martenson/disposable-email-domains",
-		Depth: 0,
-	}
+//
+// The returned Summary describes the changes made to list. Its Source is the commit hash.
+func Update(ctx context.Context, list *disposable.List) (Summary, error) {
+	return UpdateFromSource(ctx, GitSource{}, list)
+}
 
-	_, err := git.CloneContext(ctx, memory.NewStorage(), fs, opts)
-	if err != nil {
-		return err
-	}
-
-	file, err := fs.Open("disposable_email_blocklist.conf")
-	if err != nil {
-		return err
-	}
+// parseList reads one domain per line. Empty lines and comments are ignored.
+func parseList(r io.Reader) (map[string]struct{}, error) {
 
 	newList := make(map[string]struct{}, 3500)
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		newList[scanner.Text()] = struct{}{}
-	}
-
-	err = file.Close()
-	if err != nil {
-		return err
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		newList[line] = struct{}{}
 	}
 
-	err = scanner.Err()
+	err := scanner.Err()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if len(lock) > 0 && lock[0] != nil {
-		lock[0].Lock()
-		defer lock[0].Unlock()
-	}
-
-	*list = newList
-
-	return nil
+	return newList, nil
 }
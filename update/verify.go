@@ -0,0 +1,196 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ErrVerificationFailed is returned if a downloaded list fails verification.
+var ErrVerificationFailed = errors.New("list verification failed")
+
+// maxVerificationSize is the maximum size of a downloaded manifest or signature.
+const maxVerificationSize = 1 << 20
+
+// maxVerifiedListSize is the maximum size of a list that is verified. The entire list
+// must be held in memory to verify it.
+const maxVerifiedListSize = 64 << 20
+
+// Verifier verifies the integrity and authenticity of a downloaded list before it is used.
+// data is the raw content of the list.
+//
+// If verification fails, the returned error must wrap ErrVerificationFailed.
+type Verifier interface {
+	Verify(ctx context.Context, data []byte) error
+}
+
+// Checksum is a Verifier that checks that the SHA-256 digest of the list equals the
+// hex-encoded digest. It is useful for pinning a specific version of a list.
+type Checksum string
+
+// Verify implements the Verifier interface.
+func (c Checksum) Verify(ctx context.Context, data []byte) error {
+	sum := sha256.Sum256(data)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), strings.TrimSpace(string(c))) {
+		return fmt.Errorf("%w: checksum mismatch", ErrVerificationFailed)
+	}
+	return nil
+}
+
+// ChecksumManifest is a Verifier that downloads a SHA-256 manifest and checks that the
+// list's digest is found in it. The manifest is in the format produced by sha256sum:
+//
+//	<hex digest>  <file name>
+//
+// A manifest served from the same origin as the list only protects against corrupted or
+// truncated downloads (integrity). Anyone who can modify the list can also modify the
+// manifest, so use Signature if you require authenticity.
+type ChecksumManifest struct {
+	// URL of the manifest.
+	URL string
+
+	// Name of the file in the manifest. If empty, the digest may match any entry.
+	Name string
+
+	// Client is used to make the request. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Verify implements the Verifier interface.
+func (m ChecksumManifest) Verify(ctx context.Context, data []byte) error {
+	manifest, err := fetch(ctx, m.Client, m.URL)
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		// sha256sum prefixes the file name with '*' in binary mode
+		name := strings.TrimPrefix(fields[1], "*")
+		if m.Name != "" && name != m.Name {
+			continue
+		}
+
+		if strings.EqualFold(fields[0], digest) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: checksum not found in manifest", ErrVerificationFailed)
+}
+
+// Signature is a Verifier that downloads a detached Ed25519 signature of the list and checks
+// that it was signed by one of the trusted keys. The signature can be raw (64 bytes) or
+// base64-encoded.
+//
+// Example:
+//
+//	u := &update.HTTPUpdater{
+//		Verifier: update.Signature{
+//			URL:  "https://example.com/disposable_email_blocklist.conf.sig",
+//			Keys: []ed25519.PublicKey{trustedKey},
+//		},
+//	}
+type Signature struct {
+	// URL of the signature.
+	URL string
+
+	// Keys are the trusted public keys.
+	Keys []ed25519.PublicKey
+
+	// Client is used to make the request. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Verify implements the Verifier interface.
+func (s Signature) Verify(ctx context.Context, data []byte) error {
+	sig, err := fetch(ctx, s.Client, s.URL)
+	if err != nil {
+		return err
+	}
+
+	if len(sig) != ed25519.SignatureSize {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+		if err != nil {
+			return fmt.Errorf("%w: malformed signature", ErrVerificationFailed)
+		}
+		sig = decoded
+	}
+
+	for _, key := range s.Keys {
+		if len(key) == ed25519.PublicKeySize && ed25519.Verify(key, data, sig) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: invalid signature", ErrVerificationFailed)
+}
+
+// fetch downloads a small file such as a manifest or signature.
+func fetch(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return ioutil.ReadAll(io.LimitReader(resp.Body, maxVerificationSize))
+}
+
+// parseVerified reads the list from r and verifies it using v before parsing it.
+// If v is nil, the list is parsed without verification.
+func parseVerified(ctx context.Context, r io.Reader, v Verifier) (map[string]struct{}, error) {
+	if v == nil {
+		return parseList(r)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxVerifiedListSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxVerifiedListSize {
+		return nil, fmt.Errorf("list is larger than %d bytes", maxVerifiedListSize)
+	}
+
+	if err := v.Verify(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return parseList(bytes.NewReader(data))
+}
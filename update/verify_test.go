@@ -0,0 +1,104 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package update
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+func TestHTTPUpdaterVerifier(t *testing.T) {
+	list := []byte("a.com\nb.com\n")
+
+	key := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	otherKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{2}, ed25519.SeedSize))
+	sig := ed25519.Sign(key, list)
+
+	sum := sha256.Sum256(list)
+	digest := hex.EncodeToString(sum[:])
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/list":
+			w.Write(list)
+		case "/list.sig":
+			w.Write(sig)
+		case "/list.sig.b64":
+			w.Write([]byte(base64.StdEncoding.EncodeToString(sig) + "\n"))
+		case "/SHA256SUMS":
+			w.Write([]byte("0000  other.conf\n" + digest + "  *list\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		verifier Verifier
+		wantErr  error
+	}{
+		{"checksum", Checksum(digest), nil},
+		{"checksum upper-case", Checksum(bytes.ToUpper([]byte(digest))), nil},
+		{"checksum mismatch", Checksum(hex.EncodeToString(make([]byte, sha256.Size))), ErrVerificationFailed},
+		{"manifest", ChecksumManifest{URL: srv.URL + "/SHA256SUMS", Name: "list"}, nil},
+		{"manifest any name", ChecksumManifest{URL: srv.URL + "/SHA256SUMS"}, nil},
+		{"manifest name mismatch", ChecksumManifest{URL: srv.URL + "/SHA256SUMS", Name: "other.conf"}, ErrVerificationFailed},
+		{"signature", Signature{URL: srv.URL + "/list.sig", Keys: []ed25519.PublicKey{key.Public().(ed25519.PublicKey)}}, nil},
+		{"base64 signature", Signature{URL: srv.URL + "/list.sig.b64", Keys: []ed25519.PublicKey{otherKey.Public().(ed25519.PublicKey), key.Public().(ed25519.PublicKey)}}, nil},
+		{"wrong public key", Signature{URL: srv.URL + "/list.sig", Keys: []ed25519.PublicKey{otherKey.Public().(ed25519.PublicKey)}}, ErrVerificationFailed},
+		{"missing signature", Signature{URL: srv.URL + "/missing.sig", Keys: []ed25519.PublicKey{key.Public().(ed25519.PublicKey)}}, errors.New("unexpected status: 404 Not Found")},
+	}
+
+	for _, tt := range tests {
+		target := disposable.NewList(map[string]struct{}{"old.com": {}})
+		u := &HTTPUpdater{URL: srv.URL + "/list", Verifier: tt.verifier}
+
+		_, err := u.Update(context.Background(), target)
+
+		switch {
+		case tt.wantErr == nil && err != nil:
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		case tt.wantErr == ErrVerificationFailed && !errors.Is(err, ErrVerificationFailed):
+			t.Errorf("%s: got %v, want ErrVerificationFailed", tt.name, err)
+		case tt.wantErr != nil && err == nil:
+			t.Errorf("%s: expected error", tt.name)
+		}
+
+		if tt.wantErr == nil {
+			if target.Len() != 2 || !target.Contains("a.com") {
+				t.Errorf("%s: got %v, want [a.com b.com]", tt.name, target.Load())
+			}
+		} else if target.Len() != 1 || !target.Contains("old.com") {
+			t.Errorf("%s: list was modified: %v", tt.name, target.Load())
+		}
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func TestParseVerifiedTooLarge(t *testing.T) {
+	r := io.LimitReader(zeroReader{}, maxVerifiedListSize+1)
+
+	if _, err := parseVerified(context.Background(), r, Checksum("")); err == nil {
+		t.Error("expected error")
+	}
+}
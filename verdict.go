@@ -0,0 +1,65 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import "strings"
+
+// Reasons returned by DomainVerdict.
+const (
+	ReasonInvalidDomain   = "invalid domain"
+	ReasonDisposable      = "disposable"
+	ReasonSpoofedProvider = "spoofed provider"
+	ReasonSuspiciousTLD   = "suspicious tld"
+)
+
+// DomainVerdict checks domain against the loaded lists and returns whether it should be blocked
+// along with the reasons why. It is the domain-only equivalent of ParseEmail.
+//
+// The domain is blocked if it is invalid, disposable (see ActiveList, Allowlist and Denylist),
+// disposable for every local-part (see LocalPartRules) or impersonates a free email provider.
+// Domains with a top-level domain in SuspiciousTLDs are reported with ReasonSuspiciousTLD, but
+// are not blocked on that basis alone. Allowlisted domains are never blocked.
+func DomainVerdict(domain string) (blocked bool, reasons []string) {
+
+	domain, err := asciiDomain(domain)
+	if err != nil || !ValidateDomain(domain) {
+		return true, []string{ReasonInvalidDomain}
+	}
+
+	cfg := defaultConfig()
+
+	disposable, listed := cfg.lookup(domain)
+	if listed && !disposable {
+		// Allowlisted
+		return false, nil
+	}
+
+	if disposable || cfg.disposableByRule(domain) {
+		reasons = append(reasons, ReasonDisposable)
+	}
+
+	if spoofedProvider(domain) {
+		reasons = append(reasons, ReasonSpoofedProvider)
+	}
+
+	blocked = len(reasons) > 0
+
+	if _, exists := SuspiciousTLDs[domain[strings.LastIndexByte(domain, '.')+1:]]; exists {
+		reasons = append(reasons, ReasonSuspiciousTLD)
+	}
+
+	return blocked, reasons
+}
+
+// IsDisposableDomain returns true if domain is disposable according to ActiveList, Allowlist
+// and Denylist. Subdomains of disposable domains are also considered disposable.
+// Detectors are not consulted.
+func IsDisposableDomain(domain string) bool {
+	domain, err := asciiDomain(domain)
+	if err != nil {
+		return false
+	}
+
+	cfg := defaultConfig()
+	return cfg.isDisposable(domain)
+}
@@ -0,0 +1,55 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDomainVerdict(t *testing.T) {
+	LocalPartRules["throwaway.example.com"] = []LocalPartRule{{Disposable: true}}
+	t.Cleanup(func() { delete(LocalPartRules, "throwaway.example.com") })
+
+	tests := []struct {
+		domain  string
+		blocked bool
+		reasons []string
+	}{
+		{"mailinator.com", true, []string{ReasonDisposable}},
+		{"MAIL.Mailinator.com", true, []string{ReasonDisposable}},
+		{"throwaway.example.com", true, []string{ReasonDisposable}},
+		{"gmail.com.evil.tld", true, []string{ReasonSpoofedProvider}},
+		{"gmail.com.evil.xyz", true, []string{ReasonSpoofedProvider, ReasonSuspiciousTLD}},
+		{"not a domain", true, []string{ReasonInvalidDomain}},
+		{"example.xyz", false, []string{ReasonSuspiciousTLD}},
+		{"example.com", false, nil},
+		{"gmail.com", false, nil},
+		{"yahoo.com.br", false, nil},
+		{"hotmail.com.br", false, nil},
+	}
+
+	for _, tt := range tests {
+		blocked, reasons := DomainVerdict(tt.domain)
+		if blocked != tt.blocked || !reflect.DeepEqual(reasons, tt.reasons) {
+			t.Errorf("DomainVerdict(%q) = %v, %v, want %v, %v", tt.domain, blocked, reasons, tt.blocked, tt.reasons)
+		}
+	}
+}
+
+func TestIsDisposableDomain(t *testing.T) {
+	tests := map[string]bool{
+		"mailinator.com":      true,
+		"mx.mailinator.com":   true,
+		"gmail.com":           false,
+		"gmail.com.evil.tld":  false,
+		"example.com":         false,
+		"not a domain at all": false,
+	}
+
+	for domain, want := range tests {
+		if got := IsDisposableDomain(domain); got != want {
+			t.Errorf("IsDisposableDomain(%q) = %v, want %v", domain, got, want)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"net"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// DefaultVerifyTimeout is the default timeout of each DNS lookup performed by a Verifier.
+const DefaultVerifyTimeout = 5 * time.Second
+
+// DefaultVerifyCacheSize is the default maximum number of domains cached by a Verifier.
+const DefaultVerifyCacheSize = 10000
+
+// DefaultVerifier is used by ParsedEmail.Verify and VerifyBatch.
+var DefaultVerifier = &Verifier{CacheTTL: 5 * time.Minute}
+
+// Verifier checks if a domain can receive email by looking up its MX records. If there are
+// no MX records, the A/AAAA records are used instead (implicit MX as per RFC 5321).
+//
+// A Verifier is safe for concurrent use. The zero value is ready to use.
+type Verifier struct {
+	// Resolver is used to perform the DNS lookups. If nil, net.DefaultResolver is used.
+	Resolver *net.Resolver
+
+	// Timeout is the timeout for each DNS lookup. If zero, DefaultVerifyTimeout is used.
+	Timeout time.Duration
+
+	// CacheTTL is the duration successful lookups are cached for. If zero, results are not cached.
+	// Lookups that fail due to a temporary error are never cached.
+	CacheTTL time.Duration
+
+	// CacheSize is the maximum number of domains cached. The least recently used domains are
+	// evicted first. If zero, DefaultVerifyCacheSize is used.
+	CacheSize int
+
+	mu    sync.Mutex
+	ll    *list.List // most recently used at the front
+	items map[string]*list.Element
+}
+
+type verifyEntry struct {
+	domain  string
+	hasMX   bool
+	ok      bool
+	expires time.Time
+}
+
+func (v *Verifier) resolver() *net.Resolver {
+	if v.Resolver == nil {
+		return net.DefaultResolver
+	}
+	return v.Resolver
+}
+
+func (v *Verifier) timeout() time.Duration {
+	if v.Timeout == 0 {
+		return DefaultVerifyTimeout
+	}
+	return v.Timeout
+}
+
+// Verify returns true if domain has MX records or A/AAAA records. domain must be
+// lower-case and in punycode (i.e. ParsedEmail.Domain). A domain that does not exist
+// is not considered an error.
+func (v *Verifier) Verify(ctx context.Context, domain string) (bool, error) {
+	e, err := v.lookup(ctx, domain)
+	return e.ok, err
+}
+
+func (v *Verifier) lookup(ctx context.Context, domain string) (verifyEntry, error) {
+
+	if v.CacheTTL > 0 {
+		if e, exists := v.cached(domain); exists {
+			return e, nil
+		}
+	}
+
+	e := verifyEntry{domain: domain}
+
+	mxs, err := v.lookupMX(ctx, domain)
+	if err != nil {
+		return verifyEntry{}, err
+	}
+
+	if len(mxs) > 0 {
+		// Check for null MX (RFC 7505)
+		if !(len(mxs) == 1 && (mxs[0].Host == "." || mxs[0].Host == "")) {
+			e.hasMX, e.ok = true, true
+		}
+	} else {
+		addrs, err := v.lookupHost(ctx, domain)
+		if err != nil {
+			return verifyEntry{}, err
+		}
+		e.ok = len(addrs) > 0
+	}
+
+	if v.CacheTTL > 0 {
+		e.expires = time.Now().Add(v.CacheTTL)
+		v.cache(e)
+	}
+
+	return e, nil
+}
+
+// cached returns the cached entry for domain. Expired entries are removed.
+func (v *Verifier) cached(domain string) (verifyEntry, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	elem, exists := v.items[domain]
+	if !exists {
+		return verifyEntry{}, false
+	}
+
+	e := elem.Value.(verifyEntry)
+	if !time.Now().Before(e.expires) {
+		v.ll.Remove(elem)
+		delete(v.items, domain)
+		return verifyEntry{}, false
+	}
+
+	v.ll.MoveToFront(elem)
+	return e, true
+}
+
+// cache adds e to the cache and evicts the least recently used entries if it is full.
+func (v *Verifier) cache(e verifyEntry) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.items == nil {
+		v.ll = list.New()
+		v.items = map[string]*list.Element{}
+	}
+
+	if elem, exists := v.items[e.domain]; exists {
+		elem.Value = e
+		v.ll.MoveToFront(elem)
+		return
+	}
+	v.items[e.domain] = v.ll.PushFront(e)
+
+	size := v.CacheSize
+	if size <= 0 {
+		size = DefaultVerifyCacheSize
+	}
+
+	for v.ll.Len() > size {
+		oldest := v.ll.Back()
+		v.ll.Remove(oldest)
+		delete(v.items, oldest.Value.(verifyEntry).domain)
+	}
+}
+
+func (v *Verifier) lookupMX(ctx context.Context, domain string) ([]*net.MX, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout())
+	defer cancel()
+
+	mxs, err := v.resolver().LookupMX(ctx, domain)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return mxs, err
+}
+
+func (v *Verifier) lookupHost(ctx context.Context, domain string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout())
+	defer cancel()
+
+	addrs, err := v.resolver().LookupHost(ctx, domain)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return addrs, err
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// WithMXVerification checks that the domain can receive email (see Verifier). If it cannot,
+// a *ValidationError wrapping ErrNoMailServer is returned. If the DNS lookup fails, the
+// ParsedEmail is returned along with the error.
+//
+// DefaultVerifier is used unless WithResolver is provided.
+func WithMXVerification() Option {
+	return func(cfg *config) {
+		cfg.verifyMX = true
+	}
+}
+
+// WithResolver sets the resolver used by WithMXVerification. It is useful for testing.
+// The lookups are not cached.
+func WithResolver(r *net.Resolver) Option {
+	v := &Verifier{Resolver: r}
+	return func(cfg *config) {
+		cfg.verifier = v
+	}
+}
+
+// Verify returns true if the domain can receive email. It uses DefaultVerifier.
+func (p ParsedEmail) Verify(ctx context.Context) (bool, error) {
+	return DefaultVerifier.Verify(ctx, p.Domain)
+}
+
+// VerifyResult is the result of verifying a single email address.
+type VerifyResult struct {
+	// Email is the input email address.
+	Email string
+
+	// ParsedEmail is the result of parsing Email.
+	ParsedEmail ParsedEmail
+
+	// Valid is true if Email is structurally valid.
+	Valid bool
+
+	// HasMX is true if the domain has at least one MX record.
+	HasMX bool
+
+	// Err is the error returned when parsing Email or looking up the MX records.
+	// A domain that does not exist is not considered an error.
+	Err error
+}
+
+type mxResult struct {
+	hasMX bool
+	err   error
+}
+
+// VerifyBatch parses and checks the MX records of each email address. Each unique domain
+// is only looked up once, irrespective of how many times it appears in emails.
+// workers is the number of concurrent lookups. If it is not positive, runtime.NumCPU() is used.
+// It uses DefaultVerifier.
+//
+// The results are returned in the same order as emails.
+func VerifyBatch(ctx context.Context, emails []string, workers int) []VerifyResult {
+	return DefaultVerifier.VerifyBatch(ctx, emails, workers)
+}
+
+// VerifyBatch is the same as the package-level VerifyBatch, but uses v to perform the lookups.
+func (v *Verifier) VerifyBatch(ctx context.Context, emails []string, workers int) []VerifyResult {
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	results := make([]VerifyResult, len(emails))
+	domains := map[string]*mxResult{}
+	unique := []string{}
+
+	for i, email := range emails {
+		p, err := ParseEmail(email)
+		results[i] = VerifyResult{Email: email, ParsedEmail: p, Valid: err == nil, Err: err}
+		if err == nil {
+			if _, exists := domains[p.Domain]; !exists {
+				domains[p.Domain] = nil
+				unique = append(unique, p.Domain)
+			}
+		}
+	}
+
+	// Look up each unique domain
+	ch := make(chan string)
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for domain := range ch {
+				e, err := v.lookup(ctx, domain)
+				r := &mxResult{hasMX: e.hasMX, err: err}
+
+				mu.Lock()
+				domains[domain] = r
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, domain := range unique {
+		ch <- domain
+	}
+	close(ch)
+	wg.Wait()
+
+	for i := range results {
+		if !results[i].Valid {
+			continue
+		}
+		r := domains[results[i].ParsedEmail.Domain]
+		results[i].HasMX, results[i].Err = r.hasMX, r.err
+	}
+
+	return results
+}
@@ -0,0 +1,295 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package verify checks if a mailbox exists by connecting to the domain's mail server
+// and performing an SMTP callback verification (HELO/MAIL FROM/RCPT TO) without sending
+// an email.
+//
+// NOTE: Many mail servers accept every recipient (catch-all), greylist unknown senders or
+// block connections from residential IP addresses. A negative result is therefore not
+// authoritative and probing too aggressively may get your IP address blacklisted.
+package verify
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	disposable "github.com/rocketlaunchr/anti-disposable-email"
+)
+
+// DefaultTimeout is the default timeout for a probe.
+const DefaultTimeout = 15 * time.Second
+
+// ErrNoMailServer is returned if no mail server for the domain could be contacted.
+var ErrNoMailServer = errors.New("no mail server")
+
+// Result is the result of a probe.
+type Result struct {
+	// Exists is true if the mail server accepted the recipient.
+	Exists bool
+
+	// CatchAll is true if the mail server also accepted a randomly generated recipient.
+	// When true, Exists is not meaningful.
+	CatchAll bool
+
+	// Host is the mail server that was contacted.
+	Host string
+
+	// Code is the SMTP reply code for the recipient.
+	Code int
+
+	// Message is the SMTP reply message for the recipient.
+	Message string
+}
+
+// Prober performs SMTP callback verification.
+//
+// A Prober is safe for concurrent use. The zero value is ready to use.
+type Prober struct {
+	// HeloName is the name sent with the HELO/EHLO command. The default is "localhost".
+	// It should be a fully qualified domain name that resolves to your IP address.
+	HeloName string
+
+	// MailFrom is the address sent with the MAIL FROM command. The default is the
+	// null reverse-path.
+	MailFrom string
+
+	// Timeout is the maximum duration of a probe. If zero, DefaultTimeout is used.
+	Timeout time.Duration
+
+	// Resolver is used to look up the MX records. If nil, net.DefaultResolver is used.
+	Resolver *net.Resolver
+
+	// Port is the port of the mail server. The default is "25".
+	Port string
+
+	// RateLimit is the minimum interval between probes of the same domain.
+	// Probes are delayed to honor the rate limit. If zero, there is no rate limit.
+	RateLimit time.Duration
+
+	mu      sync.Mutex
+	next    map[string]time.Time
+	sweepAt int // size of next that triggers removal of stale entries
+}
+
+// minSweep is the minimum size of Prober.next before stale entries are removed.
+const minSweep = 1024
+
+// Probe checks if the mailbox of email exists.
+func (p *Prober) Probe(ctx context.Context, email string) (Result, error) {
+
+	if err := disposable.SafeForSMTP(email); err != nil {
+		return Result{}, err
+	}
+
+	parsed, err := disposable.ParseEmail(email)
+	if err != nil {
+		return Result{}, err
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	if err := p.wait(ctx, parsed.Domain); err != nil {
+		return Result{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	hosts, err := p.mailServers(ctx, parsed.Domain)
+	if err != nil {
+		return Result{}, err
+	}
+
+	rcpt := parsed.LocalPart + "@" + parsed.Domain
+
+	var lastErr error = ErrNoMailServer
+	for _, host := range hosts {
+		res, err := p.probe(ctx, host, rcpt, parsed.Domain)
+		if err == nil {
+			return res, nil
+		}
+		lastErr = err
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return Result{}, lastErr
+}
+
+// wait blocks until a probe of domain is permitted by the rate limit.
+func (p *Prober) wait(ctx context.Context, domain string) error {
+	if p.RateLimit <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	p.mu.Lock()
+	if p.next == nil {
+		p.next = map[string]time.Time{}
+	}
+	if len(p.next) >= p.sweepAt {
+		// Entries in the past no longer delay a probe
+		for d, at := range p.next {
+			if !at.After(now) {
+				delete(p.next, d)
+			}
+		}
+		p.sweepAt = max(2*len(p.next), minSweep)
+	}
+	at := p.next[domain]
+	if at.Before(now) {
+		at = now
+	}
+	p.next[domain] = at.Add(p.RateLimit)
+	p.mu.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// mailServers returns the mail servers of domain in order of preference.
+func (p *Prober) mailServers(ctx context.Context, domain string) ([]string, error) {
+	resolver := p.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	mxs, err := resolver.LookupMX(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			return nil, err
+		}
+	}
+
+	if len(mxs) == 0 {
+		// Implicit MX (RFC 5321)
+		return []string{domain}, nil
+	}
+
+	sort.SliceStable(mxs, func(i, j int) bool { return mxs[i].Pref < mxs[j].Pref })
+
+	hosts := make([]string, 0, len(mxs))
+	for _, mx := range mxs {
+		host := strings.TrimSuffix(mx.Host, ".")
+		if host == "" {
+			// Null MX (RFC 7505)
+			return nil, ErrNoMailServer
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+func (p *Prober) probe(ctx context.Context, host, rcpt, domain string) (Result, error) {
+
+	port := p.Port
+	if port == "" {
+		port = "25"
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return Result{}, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return Result{}, err
+		}
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return Result{}, err
+	}
+	defer c.Quit()
+
+	helo := p.HeloName
+	if helo == "" {
+		helo = "localhost"
+	}
+
+	if err := c.Hello(helo); err != nil {
+		return Result{}, err
+	}
+
+	if err := c.Mail(p.MailFrom); err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Host: host}
+
+	code, msg, err := rcptTo(c, rcpt)
+	if err != nil {
+		return Result{}, err
+	}
+	res.Code, res.Message = code, msg
+	res.Exists = code/100 == 2
+
+	if res.Exists {
+		// Check for catch-all
+		localPart, err := randomLocalPart()
+		if err != nil {
+			return Result{}, err
+		}
+
+		code, _, err := rcptTo(c, localPart+"@"+domain)
+		if err == nil && code/100 == 2 {
+			res.CatchAll = true
+		}
+	}
+
+	return res, nil
+}
+
+// rcptTo sends the RCPT TO command. A permanent rejection (5xx) is not returned as an error.
+func rcptTo(c *smtp.Client, rcpt string) (int, string, error) {
+	err := c.Rcpt(rcpt)
+	if err == nil {
+		return 250, "", nil
+	}
+
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) && tpErr.Code/100 == 5 {
+		return tpErr.Code, tpErr.Msg, nil
+	}
+	return 0, "", err
+}
+
+func randomLocalPart() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "probe-" + hex.EncodeToString(b), nil
+}
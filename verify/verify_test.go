@@ -0,0 +1,195 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package verify
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testMX maps a domain to its MX hosts in order of preference. The hosts are IP addresses
+// so they can be dialed without a DNS lookup. 127.0.0.2 refuses connections.
+var testMX = map[string][]string{
+	"example.com.":  {"127.0.0.1."},
+	"catchall.com.": {"127.0.0.1."},
+	"fallback.com.": {"127.0.0.2.", "127.0.0.1."},
+	"nullmx.com.":   {"."},
+}
+
+// newTestResolver starts a DNS server that answers MX queries using testMX.
+func newTestResolver(t *testing.T) *net.Resolver {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			var req dnsmessage.Message
+			if err := req.Unpack(buf[:n]); err != nil || len(req.Questions) != 1 {
+				continue
+			}
+			q := req.Questions[0]
+
+			resp := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: req.ID, Response: true, Authoritative: true},
+				Questions: req.Questions,
+			}
+
+			hosts, exists := testMX[q.Name.String()]
+			if !exists {
+				resp.RCode = dnsmessage.RCodeNameError
+			} else if q.Type == dnsmessage.TypeMX {
+				for i, host := range hosts {
+					resp.Answers = append(resp.Answers, dnsmessage.Resource{
+						Header: dnsmessage.ResourceHeader{Name: q.Name, Type: dnsmessage.TypeMX, Class: dnsmessage.ClassINET, TTL: 60},
+						Body:   &dnsmessage.MXResource{Pref: uint16(10 * (i + 1)), MX: dnsmessage.MustNewName(host)},
+					})
+				}
+			}
+
+			if b, err := resp.Pack(); err == nil {
+				conn.WriteTo(b, addr)
+			}
+		}
+	}()
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+}
+
+// newTestSMTP starts an SMTP server on 127.0.0.1 and returns its port. It accepts
+// the recipient john and every recipient of catchall.com.
+func newTestSMTP(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn)
+		}
+	}()
+
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	return port
+}
+
+func serveSMTP(conn net.Conn) {
+	defer conn.Close()
+
+	c := textproto.NewConn(conn)
+	c.PrintfLine("220 localhost ESMTP")
+
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			c.PrintfLine("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			c.PrintfLine("250 2.1.0 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			rcpt := strings.Trim(line[len("RCPT TO:"):], "<> ")
+			if strings.HasPrefix(rcpt, "john@") || strings.HasSuffix(rcpt, "@catchall.com") {
+				c.PrintfLine("250 2.1.5 OK")
+			} else {
+				c.PrintfLine("550 5.1.1 No such user")
+			}
+		case strings.HasPrefix(cmd, "QUIT"):
+			c.PrintfLine("221 2.0.0 Bye")
+			return
+		default:
+			c.PrintfLine("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+func TestProbe(t *testing.T) {
+	p := &Prober{Resolver: newTestResolver(t), Port: newTestSMTP(t), Timeout: 5 * time.Second}
+
+	tests := []struct {
+		email string
+		want  Result
+	}{
+		{"john@example.com", Result{Exists: true, Host: "127.0.0.1", Code: 250}},
+		{"jane@example.com", Result{Host: "127.0.0.1", Code: 550, Message: "5.1.1 No such user"}},
+		{"jane@catchall.com", Result{Exists: true, CatchAll: true, Host: "127.0.0.1", Code: 250}},
+		{"john@fallback.com", Result{Exists: true, Host: "127.0.0.1", Code: 250}},
+	}
+
+	for _, tt := range tests {
+		res, err := p.Probe(context.Background(), tt.email)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.email, err)
+			continue
+		}
+		if res != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.email, res, tt.want)
+		}
+	}
+
+	if _, err := p.Probe(context.Background(), "john@nullmx.com"); err != ErrNoMailServer {
+		t.Errorf("null MX: got %v, want ErrNoMailServer", err)
+	}
+}
+
+func TestProberWaitSweepsStaleEntries(t *testing.T) {
+	p := &Prober{RateLimit: time.Nanosecond}
+	ctx := context.Background()
+
+	for i := 0; i < 10*minSweep; i++ {
+		if err := p.wait(ctx, "domain"+strconv.Itoa(i)+".com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if n := len(p.next); n > 2*minSweep {
+		t.Errorf("%d rate limit entries, want at most %d", n, 2*minSweep)
+	}
+}
+
+func TestProberWaitRateLimit(t *testing.T) {
+	p := &Prober{RateLimit: 20 * time.Millisecond}
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := p.wait(ctx, "example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("3 probes took %v, want at least 40ms", elapsed)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2020-22 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package disposable
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testDNS is a DNS server for tests. Domains starting with "dead" do not exist and
+// domains starting with "fail" return SERVFAIL. All other domains have an MX record.
+type testDNS struct {
+	mu      sync.Mutex
+	queries map[string]int // "<type> <name>" => count
+}
+
+// newTestResolver starts a testDNS and returns a resolver that uses it.
+func newTestResolver(t testing.TB) (*net.Resolver, *testDNS) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	s := &testDNS{queries: map[string]int{}}
+	go s.serve(conn)
+
+	r := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+	return r, s
+}
+
+func (s *testDNS) count(typ dnsmessage.Type, name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queries[typ.String()+" "+name]
+}
+
+func (s *testDNS) serve(conn net.PacketConn) {
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		var req dnsmessage.Message
+		if err := req.Unpack(buf[:n]); err != nil || len(req.Questions) != 1 {
+			continue
+		}
+		q := req.Questions[0]
+		name := q.Name.String()
+
+		s.mu.Lock()
+		s.queries[q.Type.String()+" "+name]++
+		s.mu.Unlock()
+
+		resp := dnsmessage.Message{
+			Header:    dnsmessage.Header{ID: req.ID, Response: true, Authoritative: true, RecursionAvailable: true},
+			Questions: req.Questions,
+		}
+
+		switch {
+		case strings.HasPrefix(name, "dead"):
+			resp.RCode = dnsmessage.RCodeNameError
+		case strings.HasPrefix(name, "fail"):
+			resp.RCode = dnsmessage.RCodeServerFailure
+		case q.Type == dnsmessage.TypeMX:
+			resp.Answers = []dnsmessage.Resource{{
+				Header: dnsmessage.ResourceHeader{Name: q.Name, Type: dnsmessage.TypeMX, Class: dnsmessage.ClassINET, TTL: 60},
+				Body:   &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mx." + name)},
+			}}
+		}
+
+		if b, err := resp.Pack(); err == nil {
+			conn.WriteTo(b, addr)
+		}
+	}
+}
+
+func TestVerifyBatch(t *testing.T) {
+	r, dns := newTestResolver(t)
+	v := &Verifier{Resolver: r}
+
+	emails := []string{
+		"a@live-domain.com",
+		"b@live-domain.com",
+		"c@dead-domain.com",
+		"d@dead-domain.com",
+		"e@live-domain.com",
+		"invalid",
+		"f@dead-domain.com",
+	}
+
+	results := v.VerifyBatch(context.Background(), emails, 4)
+
+	if len(results) != len(emails) {
+		t.Fatalf("got %d results, want %d", len(results), len(emails))
+	}
+
+	for i, r := range results {
+		if r.Email != emails[i] {
+			t.Errorf("results[%d].Email = %s, want %s", i, r.Email, emails[i])
+		}
+
+		wantValid := emails[i] != "invalid"
+		wantMX := strings.HasSuffix(emails[i], "@live-domain.com")
+		if r.Valid != wantValid || r.HasMX != wantMX {
+			t.Errorf("%s: Valid = %v, HasMX = %v, want %v, %v", r.Email, r.Valid, r.HasMX, wantValid, wantMX)
+		}
+		if wantValid && r.Err != nil {
+			t.Errorf("%s: unexpected error: %v", r.Email, r.Err)
+		}
+	}
+
+	// Each unique domain must only be looked up once
+	for _, domain := range []string{"live-domain.com.", "dead-domain.com."} {
+		if n := dns.count(dnsmessage.TypeMX, domain); n != 1 {
+			t.Errorf("%s: %d MX lookups, want 1", domain, n)
+		}
+	}
+}
+
+func TestVerifierCache(t *testing.T) {
+	r, dns := newTestResolver(t)
+	v := &Verifier{Resolver: r, CacheTTL: time.Hour, CacheSize: 2}
+	ctx := context.Background()
+
+	for _, domain := range []string{"a.com", "b.com", "a.com", "c.com", "a.com", "b.com"} {
+		if _, err := v.Verify(ctx, domain); err != nil {
+			t.Fatalf("%s: unexpected error: %v", domain, err)
+		}
+	}
+
+	// b.com was evicted when c.com was cached, since a.com was used more recently
+	for domain, want := range map[string]int{"a.com.": 1, "b.com.": 2, "c.com.": 1} {
+		if n := dns.count(dnsmessage.TypeMX, domain); n != want {
+			t.Errorf("%s: %d MX lookups, want %d", domain, n, want)
+		}
+	}
+	if n := len(v.items); n != 2 {
+		t.Errorf("%d cached domains, want 2", n)
+	}
+}
+
+func TestVerifierCacheExpiry(t *testing.T) {
+	r, dns := newTestResolver(t)
+	v := &Verifier{Resolver: r, CacheTTL: time.Millisecond}
+	ctx := context.Background()
+
+	if _, err := v.Verify(ctx, "a.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if _, exists := v.cached("a.com"); exists {
+		t.Error("expired entry was returned")
+	}
+	if n := len(v.items); n != 0 {
+		t.Errorf("%d cached domains, want 0", n)
+	}
+
+	if _, err := v.Verify(ctx, "a.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := dns.count(dnsmessage.TypeMX, "a.com."); n != 2 {
+		t.Errorf("%d MX lookups, want 2", n)
+	}
+}